@@ -2,15 +2,57 @@ package main
 
 import (
 	days "advent-of-code-2024/internal"
+	"flag"
+	"fmt"
+	"os"
 )
 
 func main() {
-	// days.StartDay1()
-	// days.StartDay2Part2()
-	// days.StartDay3Part1()
-	// days.StartDay4Part1()
-	// days.StartDay4Part2()
-	// days.StartDay5Part1()
-	// days.StartDay5Part2()
-	days.StartDay6Part1()
+	day := flag.Int("day", 0, "day to run")
+	part := flag.Int("part", 0, "part to run (0 runs every solved part of the day)")
+	all := flag.Bool("all", false, "run every solved part of every day")
+	check := flag.Bool("check", false, "verify the example answers in inputs/*.answers before running")
+	flag.Parse()
+
+	if *all && (*day != 0 || *part != 0) {
+		fmt.Println("-all runs every part of every day, it cannot be combined with -day or -part")
+		flag.Usage()
+		os.Exit(2)
+	}
+	if !*all && *day == 0 {
+		fmt.Println("Pass -day or -all. Solved days:", days.Days())
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *check {
+		toCheck := days.Days()
+		if !*all {
+			toCheck = []int{*day}
+		}
+		for _, d := range toCheck {
+			if err := days.CheckExamples(d); err != nil {
+				fmt.Println("Example check failed:", err)
+				os.Exit(1)
+			}
+		}
+		fmt.Println("Example answers OK")
+	}
+
+	if *all {
+		days.RunAll()
+		return
+	}
+
+	var err error
+	if *part == 0 {
+		err = days.RunDay(*day)
+	} else {
+		err = days.Run(*day, *part)
+	}
+
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
 }
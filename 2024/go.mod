@@ -1,3 +1,11 @@
 module advent-of-code-2024
 
-go 1.22.6
+go 1.24.4
+
+// The 2025 module is the shared toolkit for both years: 2024 uses its
+// answers files (runner), input parsing (parser) and grids (utils) rather
+// than keeping copies. It is not published, so it is resolved from the
+// sibling checkout, which also sets the minimum Go version above.
+replace aoc2025 => ../2025
+
+require aoc2025 v0.0.0-00010101000000-000000000000
@@ -10,12 +10,16 @@ import (
 )
 
 func StartDay1() {
-	input, err := utilities.ParseFile("inputs/day_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_1_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Printf("Total distance: %d\n", solveDay1Part1(input))
+}
+
+func solveDay1Part1(input []string) int {
 	leftArray := []int{}
 	rightArray := []int{}
 
@@ -40,5 +44,5 @@ func StartDay1() {
 		totalDistance += int(distance)
 	}
 
-	fmt.Printf("Total distance: %d\n", totalDistance)
+	return totalDistance
 }
@@ -114,12 +114,16 @@ func inputToReports(input []string) []Report {
 }
 
 func StartDay2Part1() {
-	input, err := utilities.ParseFile("inputs/day_2_input-test.txt")
+	input, err := utilities.ParseFile(inputPath("day_2_input-test.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Safe reports:", solveDay2Part1(input))
+}
+
+func solveDay2Part1(input []string) int {
 	reports := inputToReports(input)
 	safeReports := []Report{}
 
@@ -130,16 +134,20 @@ func StartDay2Part1() {
 		}
 	}
 
-	fmt.Println("Safe reports:", len(safeReports))
+	return len(safeReports)
 }
 
 func StartDay2Part2() {
-	input, err := utilities.ParseFile("inputs/day_2_input-test.txt")
+	input, err := utilities.ParseFile(inputPath("day_2_input-test.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Safe reports with dampener:", solveDay2Part2(input))
+}
+
+func solveDay2Part2(input []string) int {
 	reports := inputToReports(input)
 	safeReports := []Report{}
 
@@ -149,5 +157,5 @@ func StartDay2Part2() {
 		}
 	}
 
-	fmt.Println("Safe reports with dampener:", len(safeReports))
+	return len(safeReports)
 }
@@ -2,67 +2,69 @@ package days
 
 import (
 	"advent-of-code-2024/utilities"
+	"aoc2025/pkg/parser"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 )
 
 func StartDay3Part1() {
-	input, err := utilities.ParseFile("inputs/day_3_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_3_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Total Results:", solveDay3Part1(input))
+}
+
+// mulRe matches a mul instruction and captures its operands
+var mulRe = regexp.MustCompile(`mul\((?P<left>\d+),(?P<right>\d+)\)`)
+
+// instructionRe also matches the do() and don't() switches
+var instructionRe = regexp.MustCompile(`mul\((?P<left>\d+),(?P<right>\d+)\)|(?P<do>do\(\))|(?P<dont>don't\(\))`)
+
+func solveDay3Part1(input []string) int {
 	// Remove all line breaks and make the input a single line
 	wholeInput := strings.Join(input, "")
 	formattedInput := strings.ReplaceAll(strings.ReplaceAll(wholeInput, "\r\n", ""), "\n", "")
-	expressionRe := regexp.MustCompile(`mul\(\d+,\d+\)`)
-	digitsRe := regexp.MustCompile(`\d{1,3}`)
-	matches := expressionRe.FindAllString(formattedInput, -1)
 
 	total := 0
-	for _, match := range matches {
-		nums := digitsRe.FindAllString(match, -1)
-		left, _ := strconv.Atoi(nums[0])
-		right, _ := strconv.Atoi(nums[1])
-		total += left * right
+	for _, match := range parser.FindAll(mulRe, formattedInput) {
+		total += match.Ints["left"] * match.Ints["right"]
 	}
 
-	fmt.Println("Total Results:", total)
+	return total
 }
 
 func StartDay3Part2() {
-	input, err := utilities.ParseFile("inputs/day_3_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_3_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Total Results:", solveDay3Part2(input))
+}
+
+func solveDay3Part2(input []string) int {
 	// Remove all line breaks and make the input a single line
 	wholeInput := strings.Join(input, "")
 	formattedInput := strings.ReplaceAll(strings.ReplaceAll(wholeInput, "\r\n", ""), "\n", "")
-	expressionRe := regexp.MustCompile(`mul\(\d+,\d+\)|do\(\)|don't\(\)`)
-	digitsRe := regexp.MustCompile(`\d{1,3}`)
-	matches := expressionRe.FindAllString(formattedInput, -1)
 
 	total := 0
 	isDisabled := false
-	for _, match := range matches {
-		if match == "don't()" {
+	for _, match := range parser.FindAll(instructionRe, formattedInput) {
+		if match.Has("dont") {
 			isDisabled = true
-		} else if match == "do()" {
+		} else if match.Has("do") {
 			isDisabled = false
 		} else {
 			if !isDisabled {
-				nums := digitsRe.FindAllString(match, -1)
-				left, _ := strconv.Atoi(nums[0])
-				right, _ := strconv.Atoi(nums[1])
-				total += left * right
+				total += match.Ints["left"] * match.Ints["right"]
 			}
 		}
 	}
 
-	fmt.Println("Total Results:", total)
+	return total
 }
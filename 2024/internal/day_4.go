@@ -55,12 +55,16 @@ func checkXmas(
 }
 
 func StartDay4Part1() {
-	input, err := utilities.ParseFile("inputs/day_4_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_4_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Total Xmas Count:", solveDay4Part1(input))
+}
+
+func solveDay4Part1(input []string) int {
 	directions := []Direction{
 		{Direction: Right, Row: 0, Col: 1},
 		{Direction: Down, Row: 1, Col: 0},
@@ -88,16 +92,20 @@ func StartDay4Part1() {
 		}
 	}
 
-	fmt.Println("Total Xmas Count:", totalXmasCount)
+	return totalXmasCount
 }
 
 func StartDay4Part2() {
-	input, err := utilities.ParseFile("inputs/day_4_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_4_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
+	fmt.Println("Total X-MAS Count:", solveDay4Part2(input))
+}
+
+func solveDay4Part2(input []string) int {
 	// Make the input a 2d array that contains the letters
 	wordSearchGrid := [][]string{}
 	for _, line := range input {
@@ -132,5 +140,5 @@ func StartDay4Part2() {
 		}
 	}
 
-	fmt.Println("Total X-MAS Count:", totalXCount)
+	return totalXCount
 }
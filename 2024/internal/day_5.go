@@ -2,6 +2,7 @@ package days
 
 import (
 	"advent-of-code-2024/utilities"
+	"aoc2025/pkg/parser"
 	"fmt"
 	"strconv"
 	"strings"
@@ -45,42 +46,67 @@ type PageUpdate struct {
 	Pages []int
 }
 
-func parseInput(input []string) ([]PageOrder, []PageUpdate) {
+func parseInput(input []string) ([]PageOrder, []PageUpdate, error) {
+	sections, err := parser.FromLines(input).SectionsN(2)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	pageOrders := []PageOrder{}
-	pageUpdates := []PageUpdate{}
-	for _, line := range input {
-		trimmedLine := strings.TrimSpace(line)
-		if strings.Contains(trimmedLine, "|") {
-			pageOrderSplit := strings.Split(trimmedLine, "|")
-			left, _ := strconv.Atoi(pageOrderSplit[0])
-			right, _ := strconv.Atoi(pageOrderSplit[1])
-			pageOrders = append(pageOrders, PageOrder{Left: left, Right: right})
-		} else {
-			if trimmedLine == "" {
-				continue
-			}
+	for _, line := range sections[0].Lines {
+		leftText, rightText, ok := strings.Cut(strings.TrimSpace(line), "|")
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid page ordering rule %q: expected \"X|Y\"", line)
+		}
+		left, err := strconv.Atoi(leftText)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid page ordering rule %q: %w", line, err)
+		}
+		right, err := strconv.Atoi(rightText)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid page ordering rule %q: %w", line, err)
+		}
+		pageOrders = append(pageOrders, PageOrder{Left: left, Right: right})
+	}
 
-			pageUpdateSplit := strings.Split(trimmedLine, ",")
-			pageUpdate := PageUpdate{Pages: []int{}}
-			for _, page := range pageUpdateSplit {
-				pageInt, _ := strconv.Atoi(strings.TrimSpace(page))
-				pageUpdate.Pages = append(pageUpdate.Pages, pageInt)
+	pageUpdates := []PageUpdate{}
+	for _, line := range sections[1].Lines {
+		pageUpdateSplit := strings.Split(strings.TrimSpace(line), ",")
+		pageUpdate := PageUpdate{Pages: []int{}}
+		for _, page := range pageUpdateSplit {
+			pageInt, err := strconv.Atoi(strings.TrimSpace(page))
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid page update %q: %w", line, err)
 			}
-			pageUpdates = append(pageUpdates, pageUpdate)
+			pageUpdate.Pages = append(pageUpdate.Pages, pageInt)
 		}
+		pageUpdates = append(pageUpdates, pageUpdate)
 	}
 
-	return pageOrders, pageUpdates
+	return pageOrders, pageUpdates, nil
 }
 
 func StartDay5Part1() {
-	input, err := utilities.ParseFile("inputs/day_5_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_5_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
-	pageOrders, pageUpdates := parseInput(input)
+	middleSum, err := solveDay5Part1(input)
+	if err != nil {
+		fmt.Println("Error parsing input:", err)
+		return
+	}
+
+	fmt.Println("Middle Sum: ", middleSum)
+}
+
+func solveDay5Part1(input []string) (int, error) {
+	pageOrders, pageUpdates, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
 
 	middleSum := 0
 	for i, update := range pageUpdates {
@@ -118,17 +144,30 @@ func StartDay5Part1() {
 		}
 	}
 
-	fmt.Println("Middle Sum: ", middleSum)
+	return middleSum, nil
 }
 
 func StartDay5Part2() {
-	input, err := utilities.ParseFile("inputs/day_5_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_5_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file:", err)
 		return
 	}
 
-	pageOrders, pageUpdates := parseInput(input)
+	middleSum, err := solveDay5Part2(input)
+	if err != nil {
+		fmt.Println("Error parsing input:", err)
+		return
+	}
+
+	fmt.Println("Middle Sum: ", middleSum)
+}
+
+func solveDay5Part2(input []string) (int, error) {
+	pageOrders, pageUpdates, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
 	invalidUpdates := []PageUpdate{}
 	for i, update := range pageUpdates {
 		isValid := true
@@ -190,5 +229,5 @@ func StartDay5Part2() {
 			i+1, pages, pages[middleIndex])
 	}
 
-	fmt.Println("Middle Sum: ", middleSum)
+	return middleSum, nil
 }
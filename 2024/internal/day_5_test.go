@@ -0,0 +1,30 @@
+package days
+
+import "testing"
+
+func TestParseInputErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+	}{
+		{"no blank line", []string{"47|53", "75,47,61"}},
+		{"too many sections", []string{"47|53", "", "75,47,61", "", "1,2"}},
+		{"rule without bar", []string{"4753", "", "75,47,61"}},
+		{"rule that is not a number", []string{"47|x", "", "75,47,61"}},
+		{"update that is not a number", []string{"47|53", "", "75,,61"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := parseInput(tt.input); err == nil {
+				t.Error("expected an error")
+			}
+			if _, err := solveDay5Part1(tt.input); err == nil {
+				t.Error("part 1: expected an error")
+			}
+			if _, err := solveDay5Part2(tt.input); err == nil {
+				t.Error("part 2: expected an error")
+			}
+		})
+	}
+}
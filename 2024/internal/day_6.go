@@ -2,6 +2,7 @@ package days
 
 import (
 	"advent-of-code-2024/utilities"
+	"aoc2025/pkg/utils"
 	"fmt"
 )
 
@@ -23,17 +24,16 @@ type Point struct {
 }
 
 type Map struct {
-	Points []Point
-	Guard  Guard
+	Grid  *utils.Grid[rune]
+	Guard Guard
 }
 
 func (m *Map) Find(x int, y int) Point {
-	for _, point := range m.Points {
-		if point.X == x && point.Y == y {
-			return point
-		}
+	char, ok := m.Grid.Get(utils.Point2D{X: x, Y: y})
+	if !ok {
+		return Point{}
 	}
-	return Point{}
+	return Point{X: x, Y: y, Type: PointType(char)}
 }
 
 type GuardDirection string
@@ -52,22 +52,21 @@ type Guard struct {
 }
 
 func parseDay6Input(input []string) (guardMap Map) {
-	guardCharacters := []string{"^", "v", ">", "<"}
-
-	for y, line := range input {
-		for x, char := range line {
-			for _, guardCharacter := range guardCharacters {
-				if string(char) == guardCharacter {
-					guard := Guard{
-						CurrentPosition:  Point{X: x, Y: y},
-						CurrentDirection: GuardDirection(guardCharacter),
-						GuardMap:         &guardMap,
-					}
-					guardMap.Guard = guard
-				}
-			}
+	guardCharacters := []rune{'^', 'v', '>', '<'}
 
-			guardMap.Points = append(guardMap.Points, Point{X: x, Y: y, Type: PointType(char)})
+	rows := [][]rune{}
+	for _, line := range input {
+		rows = append(rows, []rune(line))
+	}
+	guardMap.Grid = utils.GridFromRows(rows)
+
+	for _, guardCharacter := range guardCharacters {
+		if position, ok := utils.Find(guardMap.Grid, guardCharacter); ok {
+			guardMap.Guard = Guard{
+				CurrentPosition:  Point{X: position.X, Y: position.Y},
+				CurrentDirection: GuardDirection(guardCharacter),
+				GuardMap:         &guardMap,
+			}
 		}
 	}
 
@@ -82,12 +81,16 @@ var moves = [][]int{
 }
 
 func StartDay6Part1() {
-	input, err := utilities.ParseFile("inputs/day_6_input.txt")
+	input, err := utilities.ParseFile(inputPath("day_6_input.txt"))
 	if err != nil {
 		fmt.Println("Error parsing file: ", err)
 		return
 	}
 
+	fmt.Println(solveDay6Part1(input))
+}
+
+func solveDay6Part1(input []string) int {
 	guardMap := parseDay6Input(input)
 	guard := &guardMap.Guard
 
@@ -125,5 +128,5 @@ func StartDay6Part1() {
 		visited[key] = true
 	}
 
-	fmt.Println(len(visited))
+	return len(visited)
 }
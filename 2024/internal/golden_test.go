@@ -0,0 +1,88 @@
+package days
+
+import (
+	"advent-of-code-2024/utilities"
+	"aoc2025/pkg/runner"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden answer files with the current results")
+
+func TestMain(m *testing.M) {
+	// Tests run from the package directory, the inputs are one level up
+	inputDir = filepath.Join("..", "inputs")
+	os.Exit(m.Run())
+}
+
+// TestGoldenAnswers runs every solved part on each input of its day (the real
+// input and any -test variants) and compares the results with the answers
+// recorded in testdata/golden. Run `go test ./internal -update` to record new
+// answers after a deliberate change.
+func TestGoldenAnswers(t *testing.T) {
+	for _, day := range Days() {
+		inputPaths, err := filepath.Glob(inputPath(fmt.Sprintf("day_%d_input*.txt", day)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, inputPath := range inputPaths {
+			name := filepath.Base(inputPath)
+
+			t.Run(strings.TrimSuffix(name, ".txt"), func(t *testing.T) {
+				input, err := utilities.ParseFile(inputPath)
+				if err != nil {
+					t.Fatalf("failed to read input: %v", err)
+				}
+
+				got := runner.Answers{}
+				for _, partNum := range Parts(day) {
+					answer, err := solutions[day][partNum].solve(input)
+					if err != nil {
+						t.Errorf("part %d: %v", partNum, err)
+						continue
+					}
+					got[partNum] = runner.Int(answer)
+				}
+
+				goldenPath := filepath.Join("testdata", "golden", name)
+				if *update {
+					if err := runner.WriteAnswers(goldenPath, got); err != nil {
+						t.Fatalf("failed to write golden answers: %v", err)
+					}
+					return
+				}
+
+				want, err := runner.ReadAnswers(goldenPath)
+				if errors.Is(err, fs.ErrNotExist) {
+					t.Fatalf("no golden answers in %s, run with -update to record them", goldenPath)
+				}
+				if err != nil {
+					t.Fatalf("failed to read golden answers: %v", err)
+				}
+
+				if diff := want.Diff(got); diff != "" {
+					t.Errorf("answers changed (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+// TestExampleAnswers checks every solved part against the expected answers
+// stored next to the example inputs.
+func TestExampleAnswers(t *testing.T) {
+	for _, day := range Days() {
+		t.Run(fmt.Sprintf("day_%d", day), func(t *testing.T) {
+			if err := CheckExamples(day); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package days
+
+import (
+	"advent-of-code-2024/utilities"
+	"aoc2025/pkg/runner"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// inputDir is where the puzzle inputs and their example answers live
+var inputDir = "inputs"
+
+// inputPath returns the path of a file in inputDir
+func inputPath(name string) string {
+	return filepath.Join(inputDir, name)
+}
+
+// part pairs the entry point of a puzzle part with the function computing its answer
+type part struct {
+	start func()
+	solve func(input []string) (int, error)
+}
+
+// infallible adapts a solver that cannot fail on bad input
+func infallible(solve func(input []string) int) func(input []string) (int, error) {
+	return func(input []string) (int, error) {
+		return solve(input), nil
+	}
+}
+
+// solutions maps each day to its parts
+var solutions = map[int]map[int]part{
+	1: {1: {StartDay1, infallible(solveDay1Part1)}},
+	2: {1: {StartDay2Part1, infallible(solveDay2Part1)}, 2: {StartDay2Part2, infallible(solveDay2Part2)}},
+	3: {1: {StartDay3Part1, infallible(solveDay3Part1)}, 2: {StartDay3Part2, infallible(solveDay3Part2)}},
+	4: {1: {StartDay4Part1, infallible(solveDay4Part1)}, 2: {StartDay4Part2, infallible(solveDay4Part2)}},
+	5: {1: {StartDay5Part1, solveDay5Part1}, 2: {StartDay5Part2, solveDay5Part2}},
+	6: {1: {StartDay6Part1, infallible(solveDay6Part1)}},
+}
+
+// Days returns the days that have at least one solved part, in order
+func Days() []int {
+	days := []int{}
+	for day := range solutions {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// Parts returns the solved parts of a day, in order
+func Parts(day int) []int {
+	parts := []int{}
+	for part := range solutions[day] {
+		parts = append(parts, part)
+	}
+	sort.Ints(parts)
+	return parts
+}
+
+// Run runs a single part of a day
+func Run(day int, part int) error {
+	parts, ok := solutions[day]
+	if !ok {
+		return fmt.Errorf("day %d has no solution (available: %v)", day, Days())
+	}
+
+	solution, ok := parts[part]
+	if !ok {
+		return fmt.Errorf("day %d has no part %d (available: %v)", day, part, Parts(day))
+	}
+
+	fmt.Printf("=== Day %d Part %d ===\n", day, part)
+	solution.start()
+	return nil
+}
+
+// RunDay runs every solved part of a day
+func RunDay(day int) error {
+	if _, ok := solutions[day]; !ok {
+		return fmt.Errorf("day %d has no solution (available: %v)", day, Days())
+	}
+
+	for _, part := range Parts(day) {
+		if err := Run(day, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunAll runs every solved part of every day
+func RunAll() {
+	for _, day := range Days() {
+		// Days() only returns registered days, so this cannot fail
+		_ = RunDay(day)
+	}
+}
+
+// CheckExamples compares the solved parts of a day against the expected answers
+// stored next to its example inputs (inputs/day_N_input-test.answers).
+// Days without example answers pass.
+func CheckExamples(day int) error {
+	parts, ok := solutions[day]
+	if !ok {
+		return fmt.Errorf("day %d has no solution (available: %v)", day, Days())
+	}
+
+	answerPaths, err := filepath.Glob(inputPath(fmt.Sprintf("day_%d_input-test*.answers", day)))
+	if err != nil {
+		return err
+	}
+
+	for _, answerPath := range answerPaths {
+		want, err := runner.ReadAnswers(answerPath)
+		if err != nil {
+			return err
+		}
+
+		inputPath := strings.TrimSuffix(answerPath, ".answers") + ".txt"
+		input, err := utilities.ParseFile(inputPath)
+		if err != nil {
+			return err
+		}
+
+		got := runner.Answers{}
+		for _, part := range want.Parts() {
+			solution, ok := parts[part]
+			if !ok {
+				// Part not solved yet, nothing to check
+				delete(want, part)
+				continue
+			}
+			answer, err := solution.solve(input)
+			if err != nil {
+				return fmt.Errorf("%s: part %d: %w", filepath.Base(inputPath), part, err)
+			}
+			got[part] = runner.Int(answer)
+		}
+
+		if diff := want.Diff(got); diff != "" {
+			return fmt.Errorf("%s: example answers differ (-want +got):\n%s", filepath.Base(inputPath), diff)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"aoc2025/pkg/runner"
+)
+
+// runBench benchmarks the given days and parts, prints a report and
+// compares it with a saved baseline. It exits with status 1 when a part
+// got slower than the baseline by more than threshold percent.
+func runBench(dayNums []int, parts []int, runs int, savePath, baselinePath string, threshold float64) {
+	var baseline map[string]runner.BenchResult
+	if baselinePath != "" {
+		var err error
+		baseline, err = runner.LoadBaseline(baselinePath)
+		if err != nil {
+			log.Fatalf("Failed to load baseline: %v", err)
+		}
+	}
+
+	fmt.Printf("Benchmarking %d run(s) per part on the real input\n\n", runs)
+	fmt.Printf("%-12s %12s %12s %10s %12s  %s\n", "Part", "Min", "Median", "Allocs", "Bytes", "vs baseline")
+
+	var results []runner.BenchResult
+	regressions := 0
+	for _, dayNum := range dayNums {
+		day, _ := runner.Get(dayNum)
+		for _, part := range parts {
+			result, err := runner.Bench(day, part, runs, nil)
+			if errors.Is(err, runner.ErrNotImplemented) {
+				continue
+			}
+			if err != nil {
+				fmt.Printf("day%02d/part%d  error: %v\n", dayNum, part, err)
+				continue
+			}
+			results = append(results, result)
+
+			comparison := "-"
+			if percent, ok := result.Slowdown(baseline); ok {
+				comparison = fmt.Sprintf("%+.1f%%", percent)
+				if percent > threshold {
+					comparison += "  SLOWER"
+					regressions++
+				}
+			}
+
+			fmt.Printf("%-12s %12v %12v %10d %12d  %s\n",
+				result.Key(), result.Min, result.Median, result.Allocs, result.Bytes, comparison)
+		}
+	}
+
+	if savePath != "" {
+		if err := runner.SaveBaseline(savePath, results); err != nil {
+			log.Fatalf("Failed to save baseline: %v", err)
+		}
+		fmt.Printf("\nSaved baseline to %s\n", savePath)
+	}
+
+	if regressions > 0 {
+		fmt.Printf("\n%d part(s) got more than %.1f%% slower than the baseline\n", regressions, threshold)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"time"
+
+	_ "aoc2025/days"
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/trace"
+)
+
+func main() {
+	dayNum := flag.Int("day", 0, "day to run (required, except with --bench where 0 means every day)")
+	part := flag.Int("part", 0, "part to run (1 or 2, 0 runs both)")
+	useExample := flag.Bool("example", false, "use the example input instead of the real one")
+	check := flag.Bool("check", false, "verify the example answers in inputs/dayN_example.answers before running")
+	bench := flag.Int("bench", 0, "benchmark each part N times on the real input instead of printing answers")
+	benchSave := flag.String("bench-save", "", "save the benchmark results as a JSON baseline")
+	benchBaseline := flag.String("bench-baseline", "", "compare the benchmark results with a saved JSON baseline")
+	benchThreshold := flag.Float64("bench-threshold", 25, "percent slowdown of the median over the baseline that counts as a regression; run-to-run noise alone can exceed 10")
+	traceFormat := flag.String("trace", "off", "show the solvers' debug trace on stderr: off, tree or json (ignored with -bench)")
+	flag.Parse()
+
+	format, err := trace.ParseFormat(*traceFormat)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	if *bench > 0 {
+		dayNums := runner.Days()
+		if *dayNum != 0 {
+			if _, ok := runner.Get(*dayNum); !ok {
+				log.Fatalf("Day %d is not registered (available: %v)", *dayNum, runner.Days())
+			}
+			dayNums = []int{*dayNum}
+		}
+		runBench(dayNums, parts, *bench, *benchSave, *benchBaseline, *benchThreshold)
+		return
+	}
+
+	day, ok := runner.Get(*dayNum)
+	if !ok {
+		log.Fatalf("Day %d is not registered (available: %v)", *dayNum, runner.Days())
+	}
+
+	if *check {
+		err := runner.CheckExamples(day)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			fmt.Printf("Day %d has no example answers, skipping the check\n", day.Number)
+		case err != nil:
+			log.Fatalf("Example check failed: %v", err)
+		default:
+			fmt.Println("Example answers OK")
+		}
+	}
+
+	var input *parser.Input
+
+	if *useExample {
+		input, err = parser.ReadExample(day.Number)
+	} else {
+		input, err = parser.ReadInput(day.Number)
+	}
+
+	if err != nil {
+		log.Fatalf("Failed to read input: %v", err)
+	}
+
+	fmt.Printf("=== Day %d ===\n", day.Number)
+	trace.Enable(os.Stderr, format)
+
+	// Parse once up front so the parts' timings only cover solving
+	start := time.Now()
+	span := trace.Start("parse", "day", day.Number)
+	prepared, err := day.Prepare(input)
+	span.End()
+	if err != nil {
+		log.Fatalf("Failed to parse input: %v", err)
+	}
+	if day.HasParsePhase() {
+		fmt.Printf("Parse: %v\n", time.Since(start).Round(time.Microsecond))
+	}
+
+	failed := false
+	for _, p := range parts {
+		start := time.Now()
+		span := trace.Start("part", "day", day.Number, "part", p)
+		answer, err := runner.SolvePrepared(prepared, p)
+		elapsed := time.Since(start).Round(time.Microsecond)
+		span.End("answer", answer)
+
+		if errors.Is(err, runner.ErrNotImplemented) {
+			fmt.Printf("Part %d: not implemented\n", p)
+			continue
+		}
+		if err != nil {
+			// Report the failure and keep going so the other part still runs
+			fmt.Printf("Part %d: error: %v (%v)\n", p, err, elapsed)
+			failed = true
+			continue
+		}
+		fmt.Printf("Part %d: %v (%v)\n", p, answer, elapsed)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,21 @@
+package day
+
+import (
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+)
+
+// Change the day number for each day's solution
+const day = 0
+
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
+}
+
+func solvePart1(input *parser.Input) (runner.Answer, error) {
+	return runner.Answer{}, runner.ErrNotImplemented
+}
+
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	return runner.Answer{}, runner.ErrNotImplemented
+}
@@ -1,44 +1,29 @@
-package main
+package day01
 
 import (
 	"fmt"
-	"log"
 
 	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
 )
 
 const day = 1
 
-func main() {
-	// Set to true to use example input for debugging
-	useExample := false
-
-	var input *parser.Input
-	var err error
-
-	if useExample {
-		input, err = parser.ReadExample(day)
-	} else {
-		input, err = parser.ReadInput(day)
-	}
-
-	if err != nil {
-		log.Fatalf("Failed to read input: %v", err)
-	}
-
-	fmt.Printf("=== Day %d ===\n", day)
-	fmt.Printf("Part 1: %v\n", solvePart1(input))
-	fmt.Printf("Part 2: %v\n", solvePart2(input))
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
 }
 
-func solvePart1(input *parser.Input) any {
+func solvePart1(input *parser.Input) (runner.Answer, error) {
 	position := 50
 	var password int
 
 	for _, line := range input.Lines {
 		var action rune
 		var value int
-		fmt.Sscanf(line, "%c%d", &action, &value)
+		_, err := fmt.Sscanf(line, "%c%d", &action, &value)
+		if err != nil {
+			return runner.Answer{}, fmt.Errorf("failed to parse line '%s': %w", line, err)
+		}
 
 		if action == 'L' {
 			value = -value
@@ -50,10 +35,10 @@ func solvePart1(input *parser.Input) any {
 		}
 	}
 
-	return password
+	return runner.Int(password), nil
 }
 
-func solvePart2(input *parser.Input) any {
+func solvePart2(input *parser.Input) (runner.Answer, error) {
 	position := 50
 	var password int
 
@@ -62,7 +47,7 @@ func solvePart2(input *parser.Input) any {
 		var value int
 		_, err := fmt.Sscanf(line, "%c%d", &action, &value)
 		if err != nil {
-			log.Fatalf("Failed to parse line '%s': %v", line, err)
+			return runner.Answer{}, fmt.Errorf("failed to parse line '%s': %w", line, err)
 		}
 
 		// Count full rotations (each full 100-step rotation passes 0 once)
@@ -86,5 +71,5 @@ func solvePart2(input *parser.Input) any {
 		}
 	}
 
-	return password
+	return runner.Int(password), nil
 }
@@ -0,0 +1,106 @@
+package day02
+
+import (
+	"fmt"
+	"strconv"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+)
+
+const day = 2
+
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
+}
+
+func solvePart1(input *parser.Input) (runner.Answer, error) {
+	ranges, err := input.Ranges()
+	if err != nil {
+		return runner.Answer{}, err
+	}
+
+	invalidIds := []string{}
+
+	for _, r := range ranges {
+		for id := r.Lo; id <= r.Hi; id++ {
+			idStr := strconv.Itoa(id)
+			left := idStr[:len(idStr)/2]
+			right := idStr[len(idStr)/2:]
+
+			if left == right {
+				invalidIds = append(invalidIds, idStr)
+			}
+		}
+	}
+
+	sum := 0
+
+	for _, id := range invalidIds {
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			return runner.Answer{}, fmt.Errorf("failed to convert ID %q to integer: %w", id, err)
+		}
+
+		sum += idInt
+	}
+
+	return runner.Int(sum), nil
+}
+
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	ranges, err := input.Ranges()
+	if err != nil {
+		return runner.Answer{}, err
+	}
+
+	invalidIds := []int{}
+
+	for _, r := range ranges {
+		for id := r.Lo; id <= r.Hi; id++ {
+			if isRepeatingPattern(strconv.Itoa(id)) {
+				invalidIds = append(invalidIds, id)
+			}
+		}
+	}
+
+	sum := 0
+	for _, id := range invalidIds {
+		sum += id
+	}
+
+	return runner.Int(sum), nil
+}
+
+// isRepeatingPattern checks if a string is made of a pattern repeated at least twice
+// Algorithm: Try all possible pattern lengths from 1 to len/2
+// A pattern length must divide the total length evenly
+func isRepeatingPattern(s string) bool {
+	n := len(s)
+
+	// Try each possible pattern length
+	for patternLen := 1; patternLen <= n/2; patternLen++ {
+		// Optimization: pattern length must divide n evenly
+		if n%patternLen != 0 {
+			continue
+		}
+
+		pattern := s[:patternLen]
+		isValid := true
+
+		// Check if the pattern repeats throughout the string
+		for i := patternLen; i < n; i += patternLen {
+			if s[i:i+patternLen] != pattern {
+				isValid = false
+				break
+			}
+		}
+
+		if isValid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+package day02
+
+import (
+	"testing"
+
+	"aoc2025/pkg/parser"
+)
+
+func TestRejectsMalformedRanges(t *testing.T) {
+	for _, line := range []string{"11-22,95", "11-22,x-5", "11-22,95-", "1-2-3"} {
+		input := parser.FromLines([]string{line})
+		if answer, err := solvePart1(input); err == nil {
+			t.Errorf("part 1 on %q: got %v, want an error", line, answer)
+		}
+		if answer, err := solvePart2(input); err == nil {
+			t.Errorf("part 2 on %q: got %v, want an error", line, answer)
+		}
+	}
+}
@@ -1,39 +1,21 @@
-package main
+package day03
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
 )
 
 const day = 3
 
-func main() {
-	// Set to true to use example input for debugging
-	useExample := false
-
-	var input *parser.Input
-	var err error
-
-	if useExample {
-		input, err = parser.ReadExample(day)
-	} else {
-		input, err = parser.ReadInput(day)
-	}
-
-	if err != nil {
-		log.Fatalf("Failed to read input: %v", err)
-	}
-
-	fmt.Printf("=== Day %d ===\n", day)
-	fmt.Printf("Part 1: %v\n", solvePart1(input))
-	fmt.Printf("Part 2: %v\n", solvePart2(input))
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
 }
 
-func solvePart1(input *parser.Input) any {
+func solvePart1(input *parser.Input) (runner.Answer, error) {
 
 	sum := 0
 	for _, line := range input.Lines {
@@ -42,7 +24,7 @@ func solvePart1(input *parser.Input) any {
 			joltage, err := strconv.Atoi(char)
 
 			if err != nil {
-				log.Fatalf("Failed to convert character to integer: %v", err)
+				return runner.Answer{}, fmt.Errorf("failed to convert character %q to integer: %w", char, err)
 			}
 
 			joltages = append(joltages, joltage)
@@ -57,7 +39,7 @@ func solvePart1(input *parser.Input) any {
 				joltage, err := strconv.Atoi(fmt.Sprintf("%d%d", left, right))
 
 				if err != nil {
-					log.Fatalf("Failed to convert concatenated joltage to integer: %v", err)
+					return runner.Answer{}, fmt.Errorf("failed to convert concatenated joltage to integer: %w", err)
 				}
 
 				if joltage > highestJolstage {
@@ -69,10 +51,10 @@ func solvePart1(input *parser.Input) any {
 		sum += highestJolstage
 	}
 
-	return sum
+	return runner.Int(sum), nil
 }
 
-func solvePart2(input *parser.Input) any {
+func solvePart2(input *parser.Input) (runner.Answer, error) {
 	var sum int64 = 0
 	k := 12 // number of digits to select
 
@@ -82,7 +64,7 @@ func solvePart2(input *parser.Input) any {
 		for _, char := range strings.Split(line, "") {
 			digit, err := strconv.Atoi(char)
 			if err != nil {
-				log.Fatalf("Failed to convert character to integer: %v", err)
+				return runner.Answer{}, fmt.Errorf("failed to convert character %q to integer: %w", char, err)
 			}
 			digits = append(digits, digit)
 		}
@@ -116,9 +98,12 @@ func solvePart2(input *parser.Input) any {
 		for _, d := range selected {
 			numStr += strconv.Itoa(d)
 		}
-		num, _ := strconv.ParseInt(numStr, 10, 64)
+		num, err := strconv.ParseInt(numStr, 10, 64)
+		if err != nil {
+			return runner.Answer{}, fmt.Errorf("failed to convert selected digits %q to integer: %w", numStr, err)
+		}
 		sum += num
 	}
 
-	return sum
+	return runner.Int64(sum), nil
 }
@@ -0,0 +1,68 @@
+package day04
+
+import (
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/utils"
+)
+
+const day = 4
+
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
+}
+
+const PAPER_ROLL = '@'
+
+func solvePart1(input *parser.Input) (runner.Answer, error) {
+	grid := utils.GridFromInput(input)
+
+	accessibleRolls := 0
+	// We need to check a roll and their adjacents top, bottom, left, right and all diagonals
+	for _, pos := range utils.FindAll(grid, PAPER_ROLL) {
+		if isAccessible(grid, pos) {
+			accessibleRolls++
+		}
+	}
+
+	return runner.Int(accessibleRolls), nil
+}
+
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	grid := utils.GridFromInput(input)
+
+	totalRemovedRolls := 0
+	for {
+		removedRolls := 0
+		for _, pos := range utils.FindAll(grid, PAPER_ROLL) {
+			if isAccessible(grid, pos) {
+				removedRolls++
+				// Replace it with a "x" to mark it as counted
+				grid.Set(pos, 'x')
+			}
+		}
+
+		if removedRolls == 0 {
+			break
+		}
+
+		totalRemovedRolls += removedRolls
+	}
+
+	return runner.Int(totalRemovedRolls), nil
+}
+
+// isAccessible checks if fewer than 4 of the 8 cells around pos hold a roll
+func isAccessible(grid *utils.Grid[rune], pos utils.Point2D) bool {
+	return calculateAdjacentRolls(grid, pos) < 4
+}
+
+func calculateAdjacentRolls(grid *utils.Grid[rune], pos utils.Point2D) int {
+	count := 0
+	for _, cell := range grid.Neighbors(pos, utils.AllDirs) {
+		if cell == PAPER_ROLL {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,69 @@
+package day05
+
+import (
+	"fmt"
+	"math/big"
+
+	"aoc2025/pkg/interval"
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+)
+
+const day = 5
+
+func init() {
+	runner.Register(day, runner.Parsed(parseInventory, solvePart1, solvePart2))
+}
+
+// Inventory holds the fresh ID ranges and the ingredient IDs to check
+type Inventory struct {
+	Fresh       *interval.Set
+	Ingredients []int64
+}
+
+// parseInventory reads the "start-end" range section and the section of
+// ingredient IDs below it
+func parseInventory(input *parser.Input) (Inventory, error) {
+	sections, err := input.SectionsN(2)
+	if err != nil {
+		return Inventory{}, err
+	}
+
+	ranges, err := parser.DecodeLines[parser.Range](sections[0], "{Lo}-{Hi}")
+	if err != nil {
+		return Inventory{}, fmt.Errorf("invalid range: %w", err)
+	}
+	inventory := Inventory{Fresh: interval.New()}
+	for _, r := range ranges {
+		inventory.Fresh.Insert(int64(r.Lo), int64(r.Hi))
+	}
+
+	ids, err := sections[1].ToInts()
+	if err != nil {
+		return Inventory{}, fmt.Errorf("invalid ingredient ID: %w", err)
+	}
+	for _, id := range ids {
+		inventory.Ingredients = append(inventory.Ingredients, int64(id))
+	}
+
+	return inventory, nil
+}
+
+func solvePart1(inventory Inventory) (runner.Answer, error) {
+	totalFresh := 0
+	for _, ingredient := range inventory.Ingredients {
+		// Fresh if in ANY range, spoiled otherwise
+		if inventory.Fresh.Contains(ingredient) {
+			totalFresh++
+		}
+	}
+
+	return runner.Int(totalFresh), nil
+}
+
+func solvePart2(inventory Inventory) (runner.Answer, error) {
+	// The set merges overlapping and touching ranges, so its length counts
+	// every fresh ID exactly once
+	total := new(big.Int).SetUint64(inventory.Fresh.Len())
+	return runner.BigInt(total), nil
+}
@@ -0,0 +1,133 @@
+package day06
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/trace"
+)
+
+const day = 6
+
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
+}
+
+// =============================================================================
+// PROBLEMS
+// =============================================================================
+//
+// Input looks like:
+//   123 328  51 64
+//    45 64  387 23
+//     6 98  215 314
+//   *   +   *   +
+//
+// Each problem is a block of columns between columns that are blank on every
+// line, with its operator on the bottom row. Part 1 reads the numbers in a
+// block row by row; part 2 reads them column by column, right to left:
+//
+//   Block 3 rows:    "64 ", "23 ", "314"  → 64 + 23 + 314
+//   Block 3 columns: "  4", "431", "623"  → 4 + 431 + 623
+//
+// =============================================================================
+
+func solvePart1(input *parser.Input) (runner.Answer, error) {
+	trace.Event("horizontal reading")
+	return solve(input, func(block *parser.Block) []string {
+		return block.Rows[:block.Height()-1]
+	})
+}
+
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	trace.Event("vertical reading")
+	return solve(input, func(block *parser.Block) []string {
+		numbers := block.Columns()
+		slices.Reverse(numbers)
+		for i, column := range numbers {
+			numbers[i] = column[:block.Height()-1] // without the operator row
+		}
+		return numbers
+	})
+}
+
+// solve evaluates every problem block and sums the results. numbers picks
+// the text of each number out of a block, leaving out the operator row.
+func solve(input *parser.Input, numbers func(block *parser.Block) []string) (runner.Answer, error) {
+	grandTotal := 0
+	for index, block := range input.ColumnBlocks() {
+		var span trace.Span
+		if trace.Enabled() {
+			// Rows are shown with spaces as dots so the alignment is visible
+			rows := strings.ReplaceAll(strings.Join(block.Rows, "|"), " ", "·")
+			span = trace.Start("problem", "index", index, "firstColumn", block.Left, "rows", rows)
+		}
+
+		operator := strings.TrimSpace(block.Rows[block.Height()-1])
+		if len(operator) != 1 {
+			err := fmt.Errorf("problem at column %d: invalid operator %q", block.Left, operator)
+			span.End("error", err)
+			return runner.Answer{}, err
+		}
+
+		var values []int
+		for _, text := range numbers(block) {
+			text = strings.TrimSpace(text)
+			if text == "" {
+				continue
+			}
+			value, err := strconv.Atoi(text)
+			if err != nil {
+				err = fmt.Errorf("problem at column %d: %w", block.Left, err)
+				span.End("error", err)
+				return runner.Answer{}, err
+			}
+			values = append(values, value)
+		}
+
+		result := evaluate(values, operator[0])
+		grandTotal += result
+		span.End("numbers", values, "operator", operator, "result", result)
+	}
+
+	trace.Event("grand total", "total", grandTotal)
+
+	return runner.Int(grandTotal), nil
+}
+
+// =============================================================================
+// HELPER FUNCTIONS
+// =============================================================================
+
+// evaluate applies an operator to a list of numbers
+func evaluate(numbers []int, operator byte) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	result := numbers[0]
+
+	for i := 1; i < len(numbers); i++ {
+		previousResult := result
+		currentNumber := numbers[i]
+
+		switch operator {
+		case '+':
+			result = result + currentNumber
+		case '-':
+			result = result - currentNumber
+		case '*':
+			result = result * currentNumber
+		case '/':
+			result = result / currentNumber
+		}
+
+		trace.Event("step", "left", previousResult, "operator", string(operator), "right", currentNumber, "result", result)
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package day06
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/trace"
+)
+
+func TestErrorClosesProblemSpan(t *testing.T) {
+	var buf bytes.Buffer
+	trace.Enable(&buf, trace.Tree)
+	defer trace.Enable(nil, trace.Off)
+
+	for _, lines := range [][]string{
+		{"12 34", " 5  6", "+  *-"}, // two characters as the operator
+		{"12 3x", " 5  6", "+  * "}, // a number that does not parse
+	} {
+		buf.Reset()
+		if _, err := solvePart1(parser.FromLines(lines)); err == nil {
+			t.Fatalf("%q: want an error", lines)
+		}
+		trace.Event("after")
+		if out := buf.String(); !strings.Contains(out, "◂ problem error=") || !strings.HasSuffix(out, "\n· after\n") {
+			t.Errorf("%q: the failing problem's span was left open:\n%s", lines, out)
+		}
+	}
+}
+
+func TestTrailingBlankLine(t *testing.T) {
+	input := parser.FromLines([]string{"12 3", " 4 5", "+  *", "    "})
+	answer, err := solvePart1(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := answer.String(); got != "31" {
+		t.Errorf("got %s, want 31", got)
+	}
+}
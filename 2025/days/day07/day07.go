@@ -1,34 +1,19 @@
-package main
+package day07
 
 import (
 	"fmt"
-	"log"
 
+	"aoc2025/pkg/memo"
 	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/trace"
+	"aoc2025/pkg/utils"
 )
 
 const day = 7
 
-func main() {
-	useExample := false // Use example for learning
-
-	var input *parser.Input
-	var err error
-
-	if useExample {
-		input, err = parser.ReadExample(day)
-	} else {
-		input, err = parser.ReadInput(day)
-	}
-
-	if err != nil {
-		log.Fatalf("Failed to read input: %v", err)
-	}
-
-	fmt.Printf("=== Day %d ===\n", day)
-	fmt.Printf("Part 1: %v\n", solvePart1(input))
-	fmt.Println()
-	fmt.Printf("Part 2: %v\n", solvePart2(input))
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
 }
 
 type Position struct {
@@ -151,7 +136,7 @@ func (t *TachyonManifold) Traverse() {
 	}
 }
 
-func solvePart1(input *parser.Input) any {
+func solvePart1(input *parser.Input) (runner.Answer, error) {
 	// Build a tachyon manifold from the input
 	manifold := &TachyonManifold{
 		Splitters:   []Splitter{},
@@ -181,11 +166,11 @@ func solvePart1(input *parser.Input) any {
 
 	manifold.Traverse()
 
-	return manifold.SplitCounter
+	return runner.Int(manifold.SplitCounter), nil
 }
 
-func solvePart2(input *parser.Input) any {
-	return solvePart2WithVisualization(input, false) // Set to true for debug trace
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	return runner.Int(countQuantumTimelines(input)), nil // Run with -trace tree to see the recursion
 }
 
 // =============================================================================
@@ -218,21 +203,12 @@ func solvePart2(input *parser.Input) any {
 //
 // =============================================================================
 
-func solvePart2WithVisualization(input *parser.Input, debug bool) int {
-	// Build a grid map for O(1) lookup instead of linear search
-	grid := make(map[Position]rune)
-	var startPos Position
-	height := len(input.Lines)
-
-	for y, line := range input.Lines {
-		for x, char := range line {
-			pos := Position{x: x, y: y}
-			grid[pos] = char
-			if char == 'S' {
-				startPos = pos
-			}
-		}
-	}
+func countQuantumTimelines(input *parser.Input) int {
+	// Build a grid for O(1) lookup instead of linear search
+	grid := utils.GridFromInput(input)
+	start, _ := utils.Find(grid, 'S')
+	startPos := Position{x: start.X, y: start.Y}
+	height := grid.Height
 
 	// ═══════════════════════════════════════════════════════════════════════════
 	// MEMOIZATION CACHE - The key optimization!
@@ -254,94 +230,57 @@ func solvePart2WithVisualization(input *parser.Input, debug bool) int {
 	// Without cache: We compute sub-tree TWICE (exponential blowup!)
 	// With cache: We compute sub-tree ONCE, reuse the result
 	//
-	// This turns O(2^n) into O(n) where n = number of unique positions
+	// This turns O(2^n) into O(n) where n = number of unique positions.
+	// memo.New wraps countTimelines so every call, recursive ones included,
+	// checks the cache first.
 	//
-	memo := make(map[Position]int)
-
 	// countTimelines: Recursively count all paths from a position to the bottom
-	// Now with memoization for O(n) complexity!
-	var countTimelines func(pos Position, depth int) int
-	countTimelines = func(pos Position, depth int) int {
-		currentPos := pos
-
-		for {
-			// CHECK CACHE FIRST - Have we computed this before?
-			if cached, ok := memo[currentPos]; ok {
-				if debug {
-					fmt.Printf("%sCache hit at (%d,%d) → %d timelines\n",
-						indent(depth), currentPos.x, currentPos.y, cached)
-				}
-				return cached
-			}
-
-			// Move one step down
-			nextPos := Position{x: currentPos.x, y: currentPos.y + 1}
+	countTimelines := memo.New(func(recurse func(Position) int, pos Position) int {
+		// Move one step down
+		nextPos := Position{x: pos.x, y: pos.y + 1}
+
+		// BOTTOM REACHED - This path is ONE complete timeline!
+		if nextPos.y >= height {
+			trace.Event("bottom", "x", pos.x, "timelines", 1)
+			return 1
+		}
 
-			// BOTTOM REACHED - This path is ONE complete timeline!
-			if nextPos.y >= height {
-				if debug {
-					fmt.Printf("%sReached bottom at x=%d → 1 timeline\n",
-						indent(depth), currentPos.x)
-				}
-				memo[currentPos] = 1
-				return 1
-			}
+		// Out of bounds reads as 0 and ends the timeline below
+		char, _ := grid.Get(utils.Point2D{X: nextPos.x, Y: nextPos.y})
 
-			char := grid[nextPos]
+		switch char {
+		case '.':
+			// Empty space - continue falling down
+			return recurse(nextPos)
 
-			switch char {
-			case '.':
-				// Empty space - continue falling down
-				currentPos = nextPos
+		case '^':
+			// ═══════════════════════════════════════════════════════════════
+			// SPLITTER HIT! This is where the quantum magic happens!
+			// ═══════════════════════════════════════════════════════════════
+			leftPos := Position{x: nextPos.x - 1, y: nextPos.y}
+			rightPos := Position{x: nextPos.x + 1, y: nextPos.y}
 
-			case '^':
-				// ═══════════════════════════════════════════════════════════════
-				// SPLITTER HIT! This is where the quantum magic happens!
-				// ═══════════════════════════════════════════════════════════════
-				leftPos := Position{x: nextPos.x - 1, y: nextPos.y}
-				rightPos := Position{x: nextPos.x + 1, y: nextPos.y}
-
-				if debug {
-					fmt.Printf("%sSplitter at (%d,%d) → branching left(%d) and right(%d)\n",
-						indent(depth), nextPos.x, nextPos.y, leftPos.x, rightPos.x)
-				}
+			// Both branches nest under this splitter in the trace tree
+			span := trace.Start("splitter", "x", nextPos.x, "y", nextPos.y, "left", leftPos.x, "right", rightPos.x)
 
-				// The magic formula: total = left_timelines + right_timelines
-				leftTimelines := countTimelines(leftPos, depth+1)
-				rightTimelines := countTimelines(rightPos, depth+1)
+			// The magic formula: total = left_timelines + right_timelines
+			leftTimelines := recurse(leftPos)
+			rightTimelines := recurse(rightPos)
 
-				total := leftTimelines + rightTimelines
+			total := leftTimelines + rightTimelines
 
-				if debug {
-					fmt.Printf("%s↳ Splitter (%d,%d) produced: %d + %d = %d timelines\n",
-						indent(depth), nextPos.x, nextPos.y, leftTimelines, rightTimelines, total)
-				}
+			span.End("left", leftTimelines, "right", rightTimelines, "timelines", total)
+			return total
 
-				// CACHE THE RESULT before returning
-				memo[currentPos] = total
-				return total
-
-			default:
-				// Edge case: hit boundary or unexpected char
-				memo[currentPos] = 1
-				return 1
-			}
+		default:
+			// Edge case: hit boundary or unexpected char
+			return 1
 		}
-	}
+	})
 
-	if debug {
-		fmt.Println("\n=== TIMELINE COUNTING TRACE ===")
-		fmt.Printf("Starting from S at (%d, %d)\n\n", startPos.x, startPos.y)
-	}
+	span := trace.Start("timelines", "startX", startPos.x, "startY", startPos.y)
+	timelines := countTimelines.Get(startPos)
+	span.End("timelines", timelines, "cache", countTimelines.Stats())
 
-	return countTimelines(startPos, 0)
-}
-
-// indent returns spacing for debug output tree visualization
-func indent(depth int) string {
-	result := ""
-	for i := 0; i < depth; i++ {
-		result += "  │ "
-	}
-	return result
+	return timelines
 }
@@ -0,0 +1,71 @@
+package day08
+
+import (
+	"aoc2025/pkg/mst"
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/spatial"
+	"fmt"
+	"iter"
+	"sort"
+)
+
+const day = 8
+
+func init() {
+	runner.Register(day, runner.Parsed(parseJunctionBoxes, solvePart1, solvePart2))
+}
+
+// parseJunctionBoxes parses "x,y,z" lines into junction box positions
+func parseJunctionBoxes(input *parser.Input) ([]spatial.Point3, error) {
+	return parser.DecodeLines[spatial.Point3](input, "{X},{Y},{Z}")
+}
+
+// junctionEdges streams every pair of junction boxes as an edge, closest
+// first, weighted by squared distance
+func junctionEdges(positions []spatial.Point3) iter.Seq[mst.Edge[int]] {
+	return func(yield func(mst.Edge[int]) bool) {
+		for pair := range spatial.NewKDTree(positions).Pairs() {
+			if !yield(mst.Edge[int]{From: pair.I, To: pair.J, Weight: pair.DistSq}) {
+				return
+			}
+		}
+	}
+}
+
+// boxes returns the indices of all junction boxes
+func boxes(positions []spatial.Point3) []int {
+	indices := make([]int, len(positions))
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+func solvePart1(positions []spatial.Point3) (runner.Answer, error) {
+	// Connect the 1000 closest pairs, even those already in the same circuit
+	tree := mst.KruskalSeq(boxes(positions), junctionEdges(positions), mst.Options{MaxEdges: 1000})
+
+	// Get the three largest circuit sizes
+	sizes := tree.Sets.Sizes()
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+
+	// Multiply the three largest
+	result := 1
+	for i := 0; i < 3 && i < len(sizes); i++ {
+		result *= sizes[i]
+	}
+
+	return runner.Int(result), nil
+}
+
+func solvePart2(positions []spatial.Point3) (runner.Answer, error) {
+	// Connect closest pairs until all boxes are in one circuit
+	tree := mst.KruskalSeq(boxes(positions), junctionEdges(positions), mst.Options{})
+	if !tree.Connected {
+		return runner.Answer{}, fmt.Errorf("%d junction boxes never form a single circuit", len(positions))
+	}
+
+	// Multiply X coordinates of the last two connected boxes
+	return runner.Int(positions[tree.Last.From].X * positions[tree.Last.To].X), nil
+}
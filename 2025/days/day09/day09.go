@@ -0,0 +1,50 @@
+package day09
+
+import (
+	"aoc2025/pkg/geom"
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/utils"
+)
+
+const day = 9
+
+func init() {
+	runner.Register(day, runner.Parsed(parsePoints, solvePart1, solvePart2))
+}
+
+// parsePoints converts input lines "x,y" into the red tiles, which are the
+// vertices of a polygon in order
+func parsePoints(input *parser.Input) (geom.Polygon, error) {
+	return parser.DecodeLines[utils.Point2D](input, "{X},{Y}")
+}
+
+// findLargestRectangle finds the largest rectangle area using any two points as opposite corners
+func findLargestRectangle(points []utils.Point2D) int {
+	maxArea := 0
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			// Areas count tiles, so both edges are included
+			area := geom.RectFromCorners(points[i], points[j]).LatticePoints()
+			if area > maxArea {
+				maxArea = area
+			}
+		}
+	}
+
+	return maxArea
+}
+
+func solvePart1(points geom.Polygon) (runner.Answer, error) {
+	return runner.Int(findLargestRectangle(points)), nil
+}
+
+// solvePart2 finds the largest rectangle with red corners that only covers
+// red or green tiles, which are the tiles on or inside the polygon
+func solvePart2(points geom.Polygon) (runner.Answer, error) {
+	rect, ok := points.LargestLatticeRectangle(geom.Rect.LatticePoints)
+	if !ok {
+		return runner.Int(0), nil
+	}
+	return runner.Int(rect.LatticePoints()), nil
+}
@@ -0,0 +1,242 @@
+package day10
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"regexp"
+	"slices"
+
+	"aoc2025/pkg/gf2"
+	"aoc2025/pkg/ilp"
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+	"aoc2025/pkg/trace"
+)
+
+const day = 10
+
+func init() {
+	runner.Register(day, runner.Parsed(parseFactory, solvePart1, solvePart2))
+}
+
+// Each machine line looks like [.##.] (3) (1,3) (2) {3,5,4,7}
+var (
+	indicatorRe = regexp.MustCompile(`\[(?P<lights>[.#]+)\]`)
+	buttonRe    = regexp.MustCompile(`\((?P<counters>[0-9,]+)\)`)
+	joltageRe   = regexp.MustCompile(`\{(?P<joltages>[0-9,]+)\}`)
+)
+
+// Machine represents one machine's configuration for Part 1
+type Machine struct {
+	// Target is a bitmask where bit i is set if light i should be ON
+	Target    int
+	NumLights int
+	// Buttons is a list of bitmasks; each bitmask shows which lights that button toggles
+	Buttons []int
+}
+
+// MachinePart2 represents one machine's configuration for Part 2
+type MachinePart2 struct {
+	// Targets are the joltage requirements for each counter
+	Targets []int
+	// Buttons is a list where each button is a slice of counter indices it affects
+	Buttons [][]int
+}
+
+// Factory holds every machine of the input, in the form each part needs
+type Factory struct {
+	Machines      []Machine
+	MachinesPart2 []MachinePart2
+}
+
+// parseFactory parses each machine line once for both parts
+func parseFactory(input *parser.Input) (Factory, error) {
+	var factory Factory
+	for _, line := range input.Lines {
+		if line == "" {
+			continue
+		}
+
+		machine, err := parseMachine(line)
+		if err != nil {
+			return Factory{}, err
+		}
+		machinePart2, err := parseMachinePart2(line)
+		if err != nil {
+			return Factory{}, err
+		}
+
+		factory.Machines = append(factory.Machines, machine)
+		factory.MachinesPart2 = append(factory.MachinesPart2, machinePart2)
+	}
+	return factory, nil
+}
+
+// parseMachine parses a line like: [.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}
+func parseMachine(line string) (Machine, error) {
+	// Extract indicator pattern [...]
+	indicators := parser.FindAll(indicatorRe, line)
+	if len(indicators) == 0 {
+		return Machine{}, fmt.Errorf("no indicator pattern in %q", line)
+	}
+	pattern := indicators[0].Strings["lights"]
+
+	// Convert pattern to target bitmask
+	target := 0
+	for i, ch := range pattern {
+		if ch == '#' {
+			target |= (1 << i)
+		}
+	}
+
+	// Extract button groups (...)
+	buttonMatches := parser.FindAll(buttonRe, line)
+
+	buttons := make([]int, len(buttonMatches))
+	for i, match := range buttonMatches {
+		// Parse comma-separated indices
+		indices, err := parser.Ints(match.Strings["counters"])
+		if err != nil {
+			return Machine{}, fmt.Errorf("invalid button indices in %q: %w", line, err)
+		}
+		buttonMask := 0
+		for _, n := range indices {
+			buttonMask |= (1 << n)
+		}
+		buttons[i] = buttonMask
+	}
+
+	return Machine{
+		Target:    target,
+		NumLights: len(pattern),
+		Buttons:   buttons,
+	}, nil
+}
+
+// findMinPresses finds the minimum number of buttons to press (each 0 or 1
+// times, since pressing a button twice cancels out) to reach the target from 0.
+// Bit i of a button's mask is its coefficient in light i's equation over GF(2),
+// so the answer is the lightest solution of that linear system.
+func findMinPresses(target int, buttons []int) (int, error) {
+	// A button wired to no lights is never worth pressing, and leaving it out
+	// keeps the null space MinWeight enumerates small
+	buttons = slices.DeleteFunc(slices.Clone(buttons), func(button int) bool {
+		return button == 0
+	})
+
+	used := target
+	for _, button := range buttons {
+		used |= button
+	}
+	numLights := bits.Len(uint(used))
+
+	a := gf2.NewMatrix(numLights, len(buttons))
+	b := gf2.NewVector(numLights)
+	for light := 0; light < numLights; light++ {
+		for j, button := range buttons {
+			a.Set(light, j, button&(1<<light) != 0)
+		}
+		b.Set(light, target&(1<<light) != 0)
+	}
+
+	solution, err := gf2.Solve(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if len(solution.Basis) > gf2.MaxMinWeightDim {
+		return 0, fmt.Errorf("%d redundant buttons are too many to search", len(solution.Basis))
+	}
+	return solution.MinWeight().OnesCount(), nil
+}
+
+func solvePart1(factory Factory) (runner.Answer, error) {
+	total := 0
+	for _, machine := range factory.Machines {
+		minPresses, err := findMinPresses(machine.Target, machine.Buttons)
+		if errors.Is(err, gf2.ErrInconsistent) {
+			return runner.Answer{}, fmt.Errorf("no button combination reaches target %d", machine.Target)
+		}
+		if err != nil {
+			return runner.Answer{}, err
+		}
+		trace.Event("machine", "target", machine.Target, "presses", minPresses)
+		total += minPresses
+	}
+	return runner.Int(total), nil
+}
+
+// parseMachinePart2 parses joltage requirements and buttons for Part 2
+func parseMachinePart2(line string) (MachinePart2, error) {
+	// Extract joltage requirements {...}
+	joltages := parser.FindAll(joltageRe, line)
+	if len(joltages) == 0 {
+		return MachinePart2{}, fmt.Errorf("no joltage requirements in %q", line)
+	}
+	targets, err := parser.Ints(joltages[0].Strings["joltages"])
+	if err != nil {
+		return MachinePart2{}, fmt.Errorf("invalid joltages in %q: %w", line, err)
+	}
+
+	// Extract button groups (...) as slices of indices
+	buttonMatches := parser.FindAll(buttonRe, line)
+
+	buttons := make([][]int, len(buttonMatches))
+	for i, match := range buttonMatches {
+		buttons[i], err = parser.Ints(match.Strings["counters"])
+		if err != nil {
+			return MachinePart2{}, fmt.Errorf("invalid button indices in %q: %w", line, err)
+		}
+	}
+
+	return MachinePart2{
+		Targets: targets,
+		Buttons: buttons,
+	}, nil
+}
+
+// findMinPressesPart2 finds the fewest total presses that bring every counter
+// to its target. Each counter's target is the sum of the presses of the
+// buttons wired to it, so this is an integer program minimizing the presses.
+func findMinPressesPart2(targets []int, buttons [][]int) (int, error) {
+	a := make([][]int, len(targets))
+	for i := range a {
+		a[i] = make([]int, len(buttons))
+	}
+	for j, button := range buttons {
+		for _, counterIdx := range button {
+			a[counterIdx][j] = 1
+		}
+	}
+
+	cost := make([]int, len(buttons))
+	for j := range cost {
+		cost[j] = 1 // every press counts once
+	}
+
+	result, err := ilp.Minimize(ilp.FromInts(a, targets, cost))
+	if err != nil {
+		return 0, err
+	}
+	total, ok := result.Value.Int64()
+	if !ok {
+		return 0, fmt.Errorf("total presses %v is not an integer", result.Value)
+	}
+	return int(total), nil
+}
+
+func solvePart2(factory Factory) (runner.Answer, error) {
+	total := 0
+	for _, machine := range factory.MachinesPart2 {
+		minPresses, err := findMinPressesPart2(machine.Targets, machine.Buttons)
+		if errors.Is(err, ilp.ErrInfeasible) {
+			return runner.Answer{}, fmt.Errorf("no button presses reach joltages %v", machine.Targets)
+		}
+		if err != nil {
+			return runner.Answer{}, err
+		}
+		trace.Event("machine", "targets", machine.Targets, "presses", minPresses)
+		total += minPresses
+	}
+	return runner.Int(total), nil
+}
@@ -0,0 +1,55 @@
+package day10
+
+import (
+	"errors"
+	"testing"
+
+	"aoc2025/pkg/gf2"
+)
+
+func TestFindMinPresses(t *testing.T) {
+	many := func(n, mask int) []int {
+		buttons := make([]int, n)
+		for i := range buttons {
+			buttons[i] = mask
+		}
+		return buttons
+	}
+
+	tests := []struct {
+		name    string
+		target  int
+		buttons []int
+		want    int
+		wantErr bool
+	}{
+		{name: "example", target: 0b0110, buttons: []int{0b1000, 0b1010, 0b0100, 0b1100, 0b0101, 0b0011}, want: 2},
+		{name: "nothing to do", target: 0, buttons: []int{0b1, 0b10}, want: 0},
+		{name: "buttons wired to nothing", target: 0, buttons: many(gf2.MaxMinWeightDim+2, 0), want: 0},
+		// One button is needed, the other copies span the null space
+		{name: "redundant buttons below the limit", target: 1, buttons: many(gf2.MaxMinWeightDim-4, 1), want: 1},
+		{name: "too many redundant buttons", target: 1, buttons: many(gf2.MaxMinWeightDim+2, 1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findMinPresses(tt.target, tt.buttons)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %d presses, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d presses, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := findMinPresses(0b11, []int{0b1}); !errors.Is(err, gf2.ErrInconsistent) {
+		t.Errorf("got error %v, want %v", err, gf2.ErrInconsistent)
+	}
+}
@@ -0,0 +1,20 @@
+package day12
+
+import (
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+)
+
+const day = 12
+
+func init() {
+	runner.Register(day, runner.Funcs(solvePart1, solvePart2))
+}
+
+func solvePart1(input *parser.Input) (runner.Answer, error) {
+	return runner.Answer{}, runner.ErrNotImplemented
+}
+
+func solvePart2(input *parser.Input) (runner.Answer, error) {
+	return runner.Answer{}, runner.ErrNotImplemented
+}
@@ -0,0 +1,18 @@
+// Package days registers every day's solution with the runner.
+// Import it for its side effects.
+package days
+
+import (
+	_ "aoc2025/days/day01"
+	_ "aoc2025/days/day02"
+	_ "aoc2025/days/day03"
+	_ "aoc2025/days/day04"
+	_ "aoc2025/days/day05"
+	_ "aoc2025/days/day06"
+	_ "aoc2025/days/day07"
+	_ "aoc2025/days/day08"
+	_ "aoc2025/days/day09"
+	_ "aoc2025/days/day10"
+	_ "aoc2025/days/day11"
+	_ "aoc2025/days/day12"
+)
@@ -0,0 +1,107 @@
+package days
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/runner"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden answer files with the current results")
+
+// variants are the inputs every day is checked against
+var variants = []struct {
+	name   string
+	suffix string
+	read   func(day int) (*parser.Input, error)
+}{
+	{name: "example", suffix: "_example", read: parser.ReadExample},
+	{name: "real", suffix: "", read: parser.ReadInput},
+}
+
+// TestGoldenAnswers runs every registered day on each input variant and
+// compares the results with the answers recorded in testdata/golden.
+// Run `go test ./days -update` to record new answers after a deliberate change.
+func TestGoldenAnswers(t *testing.T) {
+	parser.InputDir = filepath.Join("..", "inputs")
+
+	for _, dayNum := range runner.Days() {
+		day, _ := runner.Get(dayNum)
+
+		for _, variant := range variants {
+			t.Run(fmt.Sprintf("day%02d/%s", dayNum, variant.name), func(t *testing.T) {
+				goldenPath := filepath.Join("testdata", "golden", fmt.Sprintf("day%02d%s.txt", dayNum, variant.suffix))
+
+				got := runner.Answers{}
+				for _, part := range []int{1, 2} {
+					// Read the input for every part, some solvers modify it in place
+					input, err := variant.read(dayNum)
+					if errors.Is(err, fs.ErrNotExist) {
+						t.Skipf("no %s input for day %d", variant.name, dayNum)
+					}
+					if err != nil {
+						t.Fatalf("failed to read input: %v", err)
+					}
+
+					answer, err := day.Solve(part, input)
+					if errors.Is(err, runner.ErrNotImplemented) {
+						continue
+					}
+					if err != nil {
+						t.Errorf("part %d: %v", part, err)
+						continue
+					}
+					got[part] = answer
+				}
+
+				if len(got) == 0 {
+					t.Skip("no part is implemented")
+				}
+
+				if *update {
+					if err := runner.WriteAnswers(goldenPath, got); err != nil {
+						t.Fatalf("failed to write golden answers: %v", err)
+					}
+					return
+				}
+
+				want, err := runner.ReadAnswers(goldenPath)
+				if errors.Is(err, fs.ErrNotExist) {
+					t.Fatalf("no golden answers in %s, run with -update to record them", goldenPath)
+				}
+				if err != nil {
+					t.Fatalf("failed to read golden answers: %v", err)
+				}
+
+				if diff := want.Diff(got); diff != "" {
+					t.Errorf("answers changed (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+// TestExampleAnswers checks every day against the expected example answers
+// stored next to its example input.
+func TestExampleAnswers(t *testing.T) {
+	parser.InputDir = filepath.Join("..", "inputs")
+
+	for _, dayNum := range runner.Days() {
+		day, _ := runner.Get(dayNum)
+
+		t.Run(fmt.Sprintf("day%02d", dayNum), func(t *testing.T) {
+			err := runner.CheckExamples(day)
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no example answers")
+			}
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
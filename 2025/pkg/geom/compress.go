@@ -0,0 +1,54 @@
+package geom
+
+import "sort"
+
+// Compression maps a set of coordinates to consecutive indices in sorted
+// order, so a grid over huge coordinates only needs one row or column per
+// distinct value
+type Compression struct {
+	values []int
+	index  map[int]int
+}
+
+// Compress builds a compression of the distinct values, in any order
+func Compress(values []int) *Compression {
+	index := make(map[int]int, len(values))
+	for _, v := range values {
+		index[v] = 0
+	}
+
+	sorted := make([]int, 0, len(index))
+	for v := range index {
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+
+	for i, v := range sorted {
+		index[v] = i
+	}
+	return &Compression{values: sorted, index: index}
+}
+
+// Len returns the number of distinct values
+func (c *Compression) Len() int {
+	return len(c.values)
+}
+
+// Index returns the position of v among the sorted values, and whether v
+// was one of them
+func (c *Compression) Index(v int) (int, bool) {
+	i, ok := c.index[v]
+	return i, ok
+}
+
+// Value returns the value at index i
+func (c *Compression) Value(i int) int {
+	return c.values[i]
+}
+
+// Values returns a copy of the sorted values
+func (c *Compression) Values() []int {
+	values := make([]int, len(c.values))
+	copy(values, c.values)
+	return values
+}
@@ -0,0 +1,261 @@
+// Package geom provides integer plane geometry: coordinate compression,
+// polygons and axis-aligned rectangles.
+//
+// All computations are exact; points use utils.Point2D.
+package geom
+
+import (
+	"fmt"
+
+	"aoc2025/pkg/prefix"
+	"aoc2025/pkg/utils"
+)
+
+// Location is where a point lies relative to a polygon
+type Location int
+
+const (
+	Outside Location = iota
+	Inside
+	Boundary
+)
+
+func (l Location) String() string {
+	switch l {
+	case Outside:
+		return "outside"
+	case Inside:
+		return "inside"
+	case Boundary:
+		return "boundary"
+	}
+	return fmt.Sprintf("Location(%d)", int(l))
+}
+
+// Polygon is a simple polygon given by its vertices in order.
+// The last vertex connects back to the first.
+type Polygon []utils.Point2D
+
+// edge returns the i-th edge, from vertex i to the next one
+func (p Polygon) edge(i int) (utils.Point2D, utils.Point2D) {
+	return p[i], p[(i+1)%len(p)]
+}
+
+// Locate reports whether q is inside p, outside it, or on one of its edges.
+// It casts a ray to the right and counts the edges crossed, using exact
+// integer arithmetic, so it works for any simple polygon.
+func (p Polygon) Locate(q utils.Point2D) Location {
+	inside := false
+	for i := range p {
+		a, b := p.edge(i)
+		if onSegment(a, b, q) {
+			return Boundary
+		}
+
+		// An edge counts when it straddles the ray's line; the half-open
+		// test counts a vertex on the line exactly once
+		if (a.Y > q.Y) == (b.Y > q.Y) {
+			continue
+		}
+		// The edge crosses the line right of q when (crossX - q.X) * dy,
+		// computed without dividing, has the sign of dy
+		dy := b.Y - a.Y
+		side := (a.X-q.X)*dy + (q.Y-a.Y)*(b.X-a.X)
+		if (side > 0) == (dy > 0) {
+			inside = !inside
+		}
+	}
+
+	if inside {
+		return Inside
+	}
+	return Outside
+}
+
+// onSegment reports whether q lies on the segment from a to b
+func onSegment(a, b, q utils.Point2D) bool {
+	cross := (b.X-a.X)*(q.Y-a.Y) - (b.Y-a.Y)*(q.X-a.X)
+	return cross == 0 &&
+		min(a.X, b.X) <= q.X && q.X <= max(a.X, b.X) &&
+		min(a.Y, b.Y) <= q.Y && q.Y <= max(a.Y, b.Y)
+}
+
+// DoubleArea returns twice the signed area from the shoelace formula.
+// It is positive when the vertices go counter-clockwise in a Y-up frame and
+// is always an integer.
+func (p Polygon) DoubleArea() int {
+	sum := 0
+	for i := range p {
+		a, b := p.edge(i)
+		sum += a.X*b.Y - b.X*a.Y
+	}
+	return sum
+}
+
+// Area returns the enclosed area, rounded down when it is a half integer
+func (p Polygon) Area() int {
+	return utils.Abs(p.DoubleArea()) / 2
+}
+
+// BoundaryPoints returns the number of integer points on the edges
+func (p Polygon) BoundaryPoints() int {
+	count := 0
+	for i := range p {
+		a, b := p.edge(i)
+		count += utils.GCD(utils.Abs(b.X-a.X), utils.Abs(b.Y-a.Y))
+	}
+	return count
+}
+
+// InteriorPoints returns the number of integer points strictly inside,
+// using Pick's theorem: A = I + B/2 - 1
+func (p Polygon) InteriorPoints() int {
+	return (utils.Abs(p.DoubleArea()) - p.BoundaryPoints() + 2) / 2
+}
+
+// LatticePoints returns the number of integer points inside or on the edges,
+// which is the number of grid tiles the polygon covers
+func (p Polygon) LatticePoints() int {
+	return p.InteriorPoints() + p.BoundaryPoints()
+}
+
+// IsRectilinear reports whether every edge is horizontal or vertical
+func (p Polygon) IsRectilinear() bool {
+	for i := range p {
+		a, b := p.edge(i)
+		if a.X != b.X && a.Y != b.Y {
+			return false
+		}
+	}
+	return true
+}
+
+// LargestRectangle finds the axis-aligned rectangle that lies entirely
+// inside p, edges included, and maximizes size, such as Rect.Area.
+// Only rectangles with two vertices of p as opposite corners are tried, so
+// a larger rectangle with other corners is not found. p must be
+// rectilinear; ok is false if it has fewer than two vertices.
+//
+// The plane is compressed to the vertex coordinates plus the open gaps
+// between them, the outside of p is flood filled on that small grid, and a
+// summed-area table answers "does this rectangle touch the outside" in
+// constant time for every pair of vertices.
+func (p Polygon) LargestRectangle(size func(Rect) int) (best Rect, ok bool) {
+	return p.largestRectangle(size, false)
+}
+
+// LargestLatticeRectangle is LargestRectangle for grids of tiles: only the
+// integer points of the rectangle need to be inside p or on its edges. A
+// gap between edges at x and x+1 holds no tile, so unlike LargestRectangle
+// a rectangle may span such a one-wide notch.
+func (p Polygon) LargestLatticeRectangle(size func(Rect) int) (best Rect, ok bool) {
+	return p.largestRectangle(size, true)
+}
+
+func (p Polygon) largestRectangle(size func(Rect) int, lattice bool) (best Rect, ok bool) {
+	if !p.IsRectilinear() {
+		panic("geom: LargestRectangle needs a rectilinear polygon")
+	}
+
+	cells := newCellGrid(p, lattice)
+	bestSize := 0
+	for i := range p {
+		for j := i + 1; j < len(p); j++ {
+			r := RectFromCorners(p[i], p[j])
+			if !cells.inside(r) {
+				continue
+			}
+			if s := size(r); !ok || s > bestSize {
+				best, bestSize, ok = r, s, true
+			}
+		}
+	}
+	return best, ok
+}
+
+// cellGrid is a polygon's plane compressed to the vertex coordinates.
+// Coordinate i sits at odd index 2i+1 and the even indices between are the
+// open gaps between consecutive coordinates; indices 0 and 2n are a one cell
+// margin around everything.
+type cellGrid struct {
+	xs, ys  *Compression
+	outside *prefix.Sum2D[int] // counts the cells outside the polygon
+}
+
+// newCellGrid compresses p. With lattice set, outside cells only count when
+// they hold an integer point, so the gap between x and x+1 never does.
+func newCellGrid(p Polygon, lattice bool) *cellGrid {
+	xValues := make([]int, len(p))
+	yValues := make([]int, len(p))
+	for i, v := range p {
+		xValues[i], yValues[i] = v.X, v.Y
+	}
+	g := &cellGrid{xs: Compress(xValues), ys: Compress(yValues)}
+
+	width, height := 2*g.xs.Len()+1, 2*g.ys.Len()+1
+	const (
+		unknown = iota
+		boundary
+		outside
+	)
+	cells := make([][]int, height)
+	for y := range cells {
+		cells[y] = make([]int, width)
+	}
+
+	// Draw the edges, which close off the inside
+	for i := range p {
+		a, b := p.edge(i)
+		from, to := g.cell(a), g.cell(b)
+		if from.X > to.X || from.Y > to.Y {
+			from, to = to, from
+		}
+		for y := from.Y; y <= to.Y; y++ {
+			for x := from.X; x <= to.X; x++ {
+				cells[y][x] = boundary
+			}
+		}
+	}
+
+	// Everything the margin reaches without crossing an edge is outside
+	cells[0][0] = outside
+	queue := []utils.Point2D{{X: 0, Y: 0}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dir := range utils.Cardinals {
+			next := current.Add(dir)
+			if utils.InBounds(next, width, height) && cells[next.Y][next.X] == unknown {
+				cells[next.Y][next.X] = outside
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	g.outside = prefix.Count(width, height, func(x, y int) bool {
+		return cells[y][x] == outside && (!lattice || (hasInteger(g.xs, x) && hasInteger(g.ys, y)))
+	})
+	return g
+}
+
+// hasInteger reports whether the cell at index i of a compressed axis
+// holds an integer: coordinates and the margins always do, the gap between
+// two coordinates only when they are more than 1 apart
+func hasInteger(c *Compression, i int) bool {
+	if i%2 == 1 || i == 0 || i == 2*c.Len() {
+		return true
+	}
+	return c.Value(i/2)-c.Value(i/2-1) > 1
+}
+
+// cell returns the grid cell of a vertex
+func (g *cellGrid) cell(v utils.Point2D) utils.Point2D {
+	x, _ := g.xs.Index(v.X)
+	y, _ := g.ys.Index(v.Y)
+	return utils.Point2D{X: 2*x + 1, Y: 2*y + 1}
+}
+
+// inside reports whether a rectangle with vertex corners avoids the outside
+func (g *cellGrid) inside(r Rect) bool {
+	return g.outside.SumRect(g.cell(r.Min), g.cell(r.Max)) == 0
+}
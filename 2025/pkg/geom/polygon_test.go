@@ -0,0 +1,179 @@
+package geom
+
+import (
+	"testing"
+
+	"aoc2025/pkg/utils"
+)
+
+func pt(x, y int) utils.Point2D {
+	return utils.Point2D{X: x, Y: y}
+}
+
+// notch is a U shape whose one-wide notch, between x=2 and x=3, holds no tile
+var notch = Polygon{pt(0, 0), pt(5, 0), pt(5, 5), pt(3, 5), pt(3, 1), pt(2, 1), pt(2, 5), pt(0, 5)}
+
+// concave shapes the rectangle searches are checked on
+var concave = map[string]Polygon{
+	"notch":      notch,
+	"wide notch": {pt(0, 0), pt(6, 0), pt(6, 5), pt(4, 5), pt(4, 1), pt(2, 1), pt(2, 5), pt(0, 5)},
+	"L":          {pt(0, 0), pt(4, 0), pt(4, 2), pt(2, 2), pt(2, 6), pt(0, 6)},
+	"plus": {pt(2, 0), pt(4, 0), pt(4, 2), pt(6, 2), pt(6, 4), pt(4, 4),
+		pt(4, 6), pt(2, 6), pt(2, 4), pt(0, 4), pt(0, 2), pt(2, 2)},
+	"clockwise": {pt(0, 0), pt(0, 5), pt(2, 5), pt(2, 1), pt(3, 1), pt(3, 5), pt(5, 5), pt(5, 0)},
+}
+
+func TestLocate(t *testing.T) {
+	square := Polygon{pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4)}
+	tests := []struct {
+		p    utils.Point2D
+		want Location
+	}{
+		{pt(2, 2), Inside},
+		{pt(0, 0), Boundary}, // vertex
+		{pt(4, 2), Boundary}, // edge
+		{pt(2, 4), Boundary},
+		{pt(5, 2), Outside},
+		{pt(-1, 4), Outside}, // the ray runs along the top edge
+		{pt(-1, 0), Outside}, // and along the bottom edge
+		{pt(2, -1), Outside},
+	}
+	for _, tt := range tests {
+		if got := square.Locate(tt.p); got != tt.want {
+			t.Errorf("square.Locate(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+
+	// The ray from (1, 1) passes through the notch's bottom vertices
+	if got := notch.Locate(pt(1, 1)); got != Inside {
+		t.Errorf("notch.Locate(1,1) = %v, want inside", got)
+	}
+	if got := notch.Locate(pt(3, 3)); got != Boundary {
+		t.Errorf("notch.Locate(3,3) = %v, want boundary", got)
+	}
+}
+
+func TestAreaAndPick(t *testing.T) {
+	shapes := map[string]Polygon{
+		"triangle": {pt(0, 0), pt(4, 0), pt(0, 3)},
+		"diamond":  {pt(2, 0), pt(4, 2), pt(2, 4), pt(0, 2)},
+	}
+	for name, p := range concave {
+		shapes[name] = p
+	}
+
+	for name, p := range shapes {
+		t.Run(name, func(t *testing.T) {
+			// Count lattice points by brute force over the bounding box
+			interior, boundary := 0, 0
+			box := bounds(p)
+			for y := box.Min.Y; y <= box.Max.Y; y++ {
+				for x := box.Min.X; x <= box.Max.X; x++ {
+					switch p.Locate(pt(x, y)) {
+					case Inside:
+						interior++
+					case Boundary:
+						boundary++
+					}
+				}
+			}
+			if got := p.BoundaryPoints(); got != boundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, boundary)
+			}
+			if got := p.InteriorPoints(); got != interior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, interior)
+			}
+			if got := p.LatticePoints(); got != interior+boundary {
+				t.Errorf("LatticePoints() = %d, want %d", got, interior+boundary)
+			}
+			// Pick's theorem: A = I + B/2 - 1
+			if got, want := utils.Abs(p.DoubleArea()), 2*interior+boundary-2; got != want {
+				t.Errorf("|DoubleArea()| = %d, want %d", got, want)
+			}
+		})
+	}
+
+	if got := notch.Area(); got != 21 {
+		t.Errorf("notch.Area() = %d, want 21", got)
+	}
+	// Reversing the vertices flips the sign of the signed area
+	if notch.DoubleArea() != -concave["clockwise"].DoubleArea() {
+		t.Error("opposite orientations should give opposite signed areas")
+	}
+}
+
+func TestLargestRectangleNotch(t *testing.T) {
+	// Every tile of the 6x6 square is on or inside the polygon
+	rect, ok := notch.LargestLatticeRectangle(Rect.LatticePoints)
+	if !ok || rect != RectFromCorners(pt(0, 0), pt(5, 5)) || rect.LatticePoints() != 36 {
+		t.Errorf("LargestLatticeRectangle = %v (%d tiles), want the 36 tile square", rect, rect.LatticePoints())
+	}
+
+	// As a region of the plane the notch is outside, so the square is not allowed
+	rect, ok = notch.LargestRectangle(Rect.Area)
+	if !ok || rect.Area() != 10 {
+		t.Errorf("LargestRectangle = %v (area %d), want area 10", rect, rect.Area())
+	}
+}
+
+func TestLargestRectangleMatchesBruteForce(t *testing.T) {
+	for name, p := range concave {
+		t.Run(name, func(t *testing.T) {
+			// Lattice: every integer point of the rectangle is covered
+			want := bruteForce(p, 1, Rect.LatticePoints)
+			if rect, _ := p.LargestLatticeRectangle(Rect.LatticePoints); rect.LatticePoints() != want {
+				t.Errorf("LargestLatticeRectangle = %v with %d tiles, want %d", rect, rect.LatticePoints(), want)
+			}
+
+			// Plane: checking every half-integer point covers the open gaps
+			want = bruteForce(p, 2, Rect.Area)
+			if rect, _ := p.LargestRectangle(Rect.Area); rect.Area() != want {
+				t.Errorf("LargestRectangle = %v with area %d, want %d", rect, rect.Area(), want)
+			}
+		})
+	}
+}
+
+func TestLargestRectanglePanicsOnSlantedEdges(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a polygon with a slanted edge")
+		}
+	}()
+	Polygon{pt(0, 0), pt(4, 0), pt(0, 3)}.LargestRectangle(Rect.Area)
+}
+
+// bruteForce returns the best size over rectangles with vertex corners
+// whose points, on a grid refined by scale, are all inside or on p
+func bruteForce(p Polygon, scale int, size func(Rect) int) int {
+	scaled := make(Polygon, len(p))
+	for i, v := range p {
+		scaled[i] = pt(v.X*scale, v.Y*scale)
+	}
+
+	best := 0
+	for i := range p {
+		for j := i + 1; j < len(p); j++ {
+			r := RectFromCorners(scaled[i], scaled[j])
+			covered := true
+			for y := r.Min.Y; y <= r.Max.Y && covered; y++ {
+				for x := r.Min.X; x <= r.Max.X && covered; x++ {
+					covered = scaled.Locate(pt(x, y)) != Outside
+				}
+			}
+			if covered {
+				best = max(best, size(RectFromCorners(p[i], p[j])))
+			}
+		}
+	}
+	return best
+}
+
+// bounds returns the bounding box of p
+func bounds(p Polygon) Rect {
+	box := Rect{Min: p[0], Max: p[0]}
+	for _, v := range p {
+		box = RectFromCorners(pt(min(box.Min.X, v.X), min(box.Min.Y, v.Y)), pt(max(box.Max.X, v.X), max(box.Max.Y, v.Y)))
+	}
+	return box
+}
@@ -0,0 +1,42 @@
+package geom
+
+import "aoc2025/pkg/utils"
+
+// Rect is an axis-aligned rectangle with integer corners, edges included
+type Rect struct {
+	Min, Max utils.Point2D
+}
+
+// RectFromCorners returns the rectangle with a and b as opposite corners
+func RectFromCorners(a, b utils.Point2D) Rect {
+	return Rect{
+		Min: utils.Point2D{X: min(a.X, b.X), Y: min(a.Y, b.Y)},
+		Max: utils.Point2D{X: max(a.X, b.X), Y: max(a.Y, b.Y)},
+	}
+}
+
+// Width returns the horizontal extent, 0 for a vertical line
+func (r Rect) Width() int {
+	return r.Max.X - r.Min.X
+}
+
+// Height returns the vertical extent, 0 for a horizontal line
+func (r Rect) Height() int {
+	return r.Max.Y - r.Min.Y
+}
+
+// Area returns the geometric area, Width * Height
+func (r Rect) Area() int {
+	return r.Width() * r.Height()
+}
+
+// LatticePoints returns the number of integer points in r, edges included.
+// This is the area counted in grid tiles, (Width+1) * (Height+1).
+func (r Rect) LatticePoints() int {
+	return (r.Width() + 1) * (r.Height() + 1)
+}
+
+// Contains reports whether p is inside r or on its edge
+func (r Rect) Contains(p utils.Point2D) bool {
+	return r.Min.X <= p.X && p.X <= r.Max.X && r.Min.Y <= p.Y && p.Y <= r.Max.Y
+}
@@ -0,0 +1,285 @@
+// Package gf2 solves linear systems over GF(2), the field of bits where
+// addition is XOR. Typical puzzles are "which switches flip these lights".
+//
+// Rows are packed into 64-bit words, so elimination handles systems with
+// hundreds of variables quickly.
+package gf2
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// ErrInconsistent is returned by Solve when the system has no solution
+var ErrInconsistent = errors.New("gf2: linear system is inconsistent")
+
+// Vector is a fixed-length bit vector.
+// Like a slice, copies of a Vector share their bits; use Clone for a
+// separate copy.
+type Vector struct {
+	n     int
+	words []uint64
+}
+
+// NewVector creates an all-zero vector of n bits
+func NewVector(n int) Vector {
+	return Vector{n: n, words: make([]uint64, (n+63)/64)}
+}
+
+// Len returns the number of bits in v
+func (v Vector) Len() int {
+	return v.n
+}
+
+func (v Vector) check(i int) {
+	if i < 0 || i >= v.n {
+		panic(fmt.Sprintf("gf2: bit %d out of range for %d-bit vector", i, v.n))
+	}
+}
+
+// Get returns bit i
+func (v Vector) Get(i int) bool {
+	v.check(i)
+	return v.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Set changes bit i
+func (v Vector) Set(i int, bit bool) {
+	v.check(i)
+	if bit {
+		v.words[i/64] |= 1 << (i % 64)
+	} else {
+		v.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// Flip toggles bit i
+func (v Vector) Flip(i int) {
+	v.check(i)
+	v.words[i/64] ^= 1 << (i % 64)
+}
+
+// Xor adds w to v in place. Both must have the same length.
+func (v Vector) Xor(w Vector) {
+	if v.n != w.n {
+		panic(fmt.Sprintf("gf2: xor of %d-bit and %d-bit vectors", v.n, w.n))
+	}
+	for i, word := range w.words {
+		v.words[i] ^= word
+	}
+}
+
+// OnesCount returns the number of set bits, also called the weight
+func (v Vector) OnesCount() int {
+	count := 0
+	for _, word := range v.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// IsZero reports whether no bit is set
+func (v Vector) IsZero() bool {
+	for _, word := range v.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Ones returns the indices of the set bits in increasing order
+func (v Vector) Ones() []int {
+	var ones []int
+	for i, word := range v.words {
+		for word != 0 {
+			ones = append(ones, i*64+bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+	return ones
+}
+
+// Clone returns a copy of v that does not share its bits
+func (v Vector) Clone() Vector {
+	words := make([]uint64, len(v.words))
+	copy(words, v.words)
+	return Vector{n: v.n, words: words}
+}
+
+// String formats v as bits from index 0 up, e.g. "0110"
+func (v Vector) String() string {
+	var sb strings.Builder
+	for i := 0; i < v.n; i++ {
+		if v.Get(i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// Matrix is a Rows x Cols bit matrix
+type Matrix struct {
+	Rows int
+	Cols int
+	rows []Vector
+}
+
+// NewMatrix creates an all-zero rows x cols matrix
+func NewMatrix(rows, cols int) *Matrix {
+	m := &Matrix{Rows: rows, Cols: cols, rows: make([]Vector, rows)}
+	for r := range m.rows {
+		m.rows[r] = NewVector(cols)
+	}
+	return m
+}
+
+// Get returns the bit at row r, column c
+func (m *Matrix) Get(r, c int) bool {
+	return m.rows[r].Get(c)
+}
+
+// Set changes the bit at row r, column c
+func (m *Matrix) Set(r, c int, bit bool) {
+	m.rows[r].Set(c, bit)
+}
+
+// Row returns a copy of row r
+func (m *Matrix) Row(r int) Vector {
+	return m.rows[r].Clone()
+}
+
+// Rank returns the number of linearly independent rows of m
+func (m *Matrix) Rank() int {
+	rows := make([]Vector, m.Rows)
+	for r := range rows {
+		rows[r] = m.rows[r].Clone()
+	}
+	return len(reduce(rows, m.Cols))
+}
+
+// reduce brings rows to reduced row echelon form over their first cols
+// columns, in place, and returns the pivot columns in increasing order
+func reduce(rows []Vector, cols int) []int {
+	var pivots []int
+	row := 0
+	for col := 0; col < cols && row < len(rows); col++ {
+		found := -1
+		for r := row; r < len(rows); r++ {
+			if rows[r].Get(col) {
+				found = r
+				break
+			}
+		}
+		if found == -1 {
+			continue // No pivot in this column
+		}
+
+		rows[row], rows[found] = rows[found], rows[row]
+		pivots = append(pivots, col)
+		for r := range rows {
+			if r != row && rows[r].Get(col) {
+				rows[r].Xor(rows[row])
+			}
+		}
+		row++
+	}
+	return pivots
+}
+
+// Solution describes every solution of a system A·x = b as Particular
+// XOR any combination of the Basis vectors
+type Solution struct {
+	Particular Vector   // the solution with every free variable 0
+	Free       []int    // variables that can take any value
+	Basis      []Vector // Basis[j] is the null space direction of Free[j]
+}
+
+// Solve finds all x with a·x = b.
+// It returns ErrInconsistent if there are none.
+func Solve(a *Matrix, b Vector) (Solution, error) {
+	if b.Len() != a.Rows {
+		return Solution{}, fmt.Errorf("gf2: right-hand side has %d bits, want %d", b.Len(), a.Rows)
+	}
+
+	// Reduce the augmented matrix [a | b], with b in the last column
+	rows := make([]Vector, a.Rows)
+	for r := range rows {
+		rows[r] = NewVector(a.Cols + 1)
+		copy(rows[r].words, a.rows[r].words)
+		rows[r].Set(a.Cols, b.Get(r))
+	}
+	pivots := reduce(rows, a.Cols+1)
+
+	// A pivot in the last column means a row reads 0 = 1
+	if len(pivots) > 0 && pivots[len(pivots)-1] == a.Cols {
+		return Solution{}, ErrInconsistent
+	}
+
+	particular := NewVector(a.Cols)
+	isPivot := make([]bool, a.Cols)
+	for r, col := range pivots {
+		particular.Set(col, rows[r].Get(a.Cols))
+		isPivot[col] = true
+	}
+
+	var free []int
+	var basis []Vector
+	for col := 0; col < a.Cols; col++ {
+		if isPivot[col] {
+			continue
+		}
+		// Flipping this free variable flips every pivot variable whose row
+		// has a bit in its column
+		v := NewVector(a.Cols)
+		v.Set(col, true)
+		for r, pivot := range pivots {
+			if rows[r].Get(col) {
+				v.Set(pivot, true)
+			}
+		}
+		free = append(free, col)
+		basis = append(basis, v)
+	}
+
+	return Solution{Particular: particular, Free: free, Basis: basis}, nil
+}
+
+// Unique reports whether the system has exactly one solution
+func (s Solution) Unique() bool {
+	return len(s.Basis) == 0
+}
+
+// MaxMinWeightDim is the largest null space dimension MinWeight accepts.
+// Enumerating 2^25 solutions takes well under a second; every extra
+// dimension doubles that. Callers with untrusted systems should check
+// len(Basis) against it.
+const MaxMinWeightDim = 25
+
+// MinWeight returns a solution with the fewest set bits.
+// It walks all 2^len(Basis) solutions in Gray code order, one XOR per step,
+// so the cost depends on the null space dimension rather than the number of
+// variables. It panics if the dimension is above MaxMinWeightDim.
+func (s Solution) MinWeight() Vector {
+	if len(s.Basis) > MaxMinWeightDim {
+		panic(fmt.Sprintf("gf2: null space of dimension %d is too large to enumerate", len(s.Basis)))
+	}
+
+	current := s.Particular.Clone()
+	best := current.Clone()
+	bestWeight := current.OnesCount()
+
+	for i := uint64(1); i < 1<<len(s.Basis); i++ {
+		// Consecutive Gray codes differ in the bit of i's lowest set bit
+		current.Xor(s.Basis[bits.TrailingZeros64(i)])
+		if w := current.OnesCount(); w < bestWeight {
+			best = current.Clone()
+			bestWeight = w
+		}
+	}
+	return best
+}
@@ -0,0 +1,118 @@
+package gf2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// system builds A·x = b from rows like "1011|1", one per equation
+func system(t *testing.T, rows ...string) (*Matrix, Vector) {
+	t.Helper()
+	cols := strings.Index(rows[0], "|")
+	a := NewMatrix(len(rows), cols)
+	b := NewVector(len(rows))
+	for r, row := range rows {
+		for c := 0; c < cols; c++ {
+			a.Set(r, c, row[c] == '1')
+		}
+		b.Set(r, row[cols+1] == '1')
+	}
+	return a, b
+}
+
+// satisfies reports whether a·x = b
+func satisfies(a *Matrix, x, b Vector) bool {
+	for r := 0; r < a.Rows; r++ {
+		bit := false
+		for c := 0; c < a.Cols; c++ {
+			bit = bit != (a.Get(r, c) && x.Get(c))
+		}
+		if bit != b.Get(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSolveInconsistent(t *testing.T) {
+	a, b := system(t,
+		"110|1",
+		"011|0",
+		"101|0", // the sum of the first two rows, with a different right-hand side
+	)
+	if _, err := Solve(a, b); !errors.Is(err, ErrInconsistent) {
+		t.Fatalf("got error %v, want %v", err, ErrInconsistent)
+	}
+}
+
+func TestSolveUnique(t *testing.T) {
+	a, b := system(t,
+		"100|1",
+		"110|0",
+		"011|1",
+	)
+	solution, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !solution.Unique() {
+		t.Fatalf("got free variables %v, want a unique solution", solution.Free)
+	}
+	if got, want := solution.Particular.String(), "110"; got != want {
+		t.Errorf("solution = %s, want %s", got, want)
+	}
+	if got := solution.MinWeight(); got.String() != solution.Particular.String() {
+		t.Errorf("MinWeight = %s, want the only solution %s", got, solution.Particular)
+	}
+}
+
+func TestMinWeightSearchesNullSpace(t *testing.T) {
+	// Setting the free x3 to 0 forces x0 = x1 = x2 = 1, while x3 = 1 alone
+	// solves every equation
+	a, b := system(t,
+		"1001|1",
+		"0101|1",
+		"0011|1",
+	)
+	solution, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(solution.Free) != 1 || solution.Free[0] != 3 {
+		t.Fatalf("free variables = %v, want [3]", solution.Free)
+	}
+	if got := solution.Particular.OnesCount(); got != 3 {
+		t.Errorf("particular solution %s has weight %d, want 3", solution.Particular, got)
+	}
+
+	best := solution.MinWeight()
+	if got, want := best.String(), "0001"; got != want {
+		t.Errorf("MinWeight = %s, want %s", got, want)
+	}
+	if !satisfies(a, solution.Particular, b) || !satisfies(a, best, b) {
+		t.Error("solutions do not satisfy the system")
+	}
+	for _, v := range solution.Basis {
+		if !satisfies(a, v, NewVector(a.Rows)) {
+			t.Errorf("basis vector %s is not in the null space", v)
+		}
+	}
+}
+
+func TestMinWeightPanicsOnLargeNullSpace(t *testing.T) {
+	// One all-zero equation leaves every variable free, one more than
+	// MinWeight is willing to enumerate
+	a := NewMatrix(1, MaxMinWeightDim+1)
+	solution, err := Solve(a, NewVector(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MinWeight did not panic")
+		}
+	}()
+	solution.MinWeight()
+}
@@ -0,0 +1,243 @@
+// Package ilp solves small integer linear programs exactly.
+//
+// Problems have the form: minimize C·x subject to A·x = B and x >= 0, with x
+// integer. Linear relaxations are solved by an exact rational simplex and
+// integrality is enforced by branch and bound, so no external solver is
+// needed and no floating point error can creep in.
+package ilp
+
+import (
+	"errors"
+	"fmt"
+
+	"aoc2025/pkg/linalg"
+	"aoc2025/pkg/rational"
+)
+
+var (
+	// ErrInfeasible is returned when no x satisfies the constraints
+	ErrInfeasible = errors.New("ilp: no feasible solution")
+	// ErrUnbounded is returned when the objective can decrease forever
+	ErrUnbounded = errors.New("ilp: objective is unbounded")
+	// ErrNodeLimit is returned when branch and bound gives up after
+	// Problem.MaxNodes relaxations
+	ErrNodeLimit = errors.New("ilp: node limit reached")
+)
+
+// Problem is: minimize C·x subject to A·x = B and x >= 0.
+//
+// Branch and bound always finishes when the constraints bound every
+// variable, as they do when A has no negative entries and every column has
+// a positive one. Otherwise it can run forever on a problem without integer
+// solutions, which MaxNodes guards against.
+type Problem struct {
+	A        *linalg.Matrix // one row per constraint, one column per variable
+	B        []rational.Rat // right-hand side, one entry per constraint
+	C        []rational.Rat // objective, one entry per variable
+	MaxNodes int            // relaxations to solve before giving up, 0 for no limit
+}
+
+// FromInts creates a problem with integer coefficients
+func FromInts(a [][]int, b, c []int) Problem {
+	return Problem{
+		A: linalg.FromInts(a),
+		B: ints(b),
+		C: ints(c),
+	}
+}
+
+func ints(values []int) []rational.Rat {
+	rats := make([]rational.Rat, len(values))
+	for i, v := range values {
+		rats[i] = rational.Int(int64(v))
+	}
+	return rats
+}
+
+// Result is an optimal solution
+type Result struct {
+	X     []rational.Rat // value of each variable
+	Value rational.Rat   // C·X
+	Nodes int            // linear relaxations solved
+}
+
+func (p Problem) validate() error {
+	if p.A == nil {
+		return errors.New("ilp: no constraint matrix")
+	}
+	if len(p.B) != p.A.Rows {
+		return fmt.Errorf("ilp: right-hand side has %d entries, want %d", len(p.B), p.A.Rows)
+	}
+	if len(p.C) != p.A.Cols {
+		return fmt.Errorf("ilp: objective has %d entries, want %d", len(p.C), p.A.Cols)
+	}
+	return nil
+}
+
+// Relax solves the linear relaxation of p, where x may be fractional
+func Relax(p Problem) (Result, error) {
+	if err := p.validate(); err != nil {
+		return Result{}, err
+	}
+	x, value, err := p.relax(newBounds(p.A.Cols))
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{X: x, Value: value, Nodes: 1}, nil
+}
+
+// Minimize finds an integer x minimizing C·x.
+// It returns ErrInfeasible if there is none, ErrUnbounded if the
+// relaxation is unbounded and ErrNodeLimit if MaxNodes runs out.
+func Minimize(p Problem) (Result, error) {
+	if err := p.validate(); err != nil {
+		return Result{}, err
+	}
+
+	// With an integer objective every integer solution has an integer value,
+	// so a relaxation can be pruned as soon as its value rounded up is no
+	// better than the best solution so far
+	integral := true
+	for _, c := range p.C {
+		integral = integral && c.IsInt()
+	}
+
+	var best *Result
+	nodes := 0
+	stack := []bounds{newBounds(p.A.Cols)}
+
+	for len(stack) > 0 {
+		if p.MaxNodes > 0 && nodes >= p.MaxNodes {
+			return Result{}, ErrNodeLimit
+		}
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		nodes++
+
+		x, value, err := p.relax(node)
+		if errors.Is(err, ErrInfeasible) {
+			continue
+		}
+		if err != nil {
+			return Result{}, err
+		}
+
+		bound := value
+		if integral {
+			bound = value.Ceil()
+		}
+		if best != nil && bound.Cmp(best.Value) >= 0 {
+			continue
+		}
+
+		branch := -1
+		for j, v := range x {
+			if !v.IsInt() {
+				branch = j
+				break
+			}
+		}
+		if branch == -1 {
+			best = &Result{X: x, Value: value}
+			continue
+		}
+
+		// Split on the fractional variable: x <= floor(v) or x >= floor(v)+1.
+		// The lower branch is pushed last so it is explored first.
+		floor := x[branch].Floor()
+		up := node.clone()
+		up.lower[branch] = floor.Add(rational.Int(1))
+		down := node.clone()
+		down.upper[branch] = floor
+		down.hasUpper[branch] = true
+		stack = append(stack, up, down)
+	}
+
+	if best == nil {
+		return Result{}, ErrInfeasible
+	}
+	best.Nodes = nodes
+	return *best, nil
+}
+
+// bounds are the extra limits on each variable added by branching
+type bounds struct {
+	lower    []rational.Rat
+	upper    []rational.Rat
+	hasUpper []bool
+}
+
+func newBounds(n int) bounds {
+	return bounds{
+		lower:    make([]rational.Rat, n),
+		upper:    make([]rational.Rat, n),
+		hasUpper: make([]bool, n),
+	}
+}
+
+func (b bounds) clone() bounds {
+	return bounds{
+		lower:    append([]rational.Rat(nil), b.lower...),
+		upper:    append([]rational.Rat(nil), b.upper...),
+		hasUpper: append([]bool(nil), b.hasUpper...),
+	}
+}
+
+// relax solves the linear relaxation of p within the given bounds.
+// Each variable is shifted to x = lower + y so that y >= 0, and each upper
+// bound becomes an equality with its own slack variable.
+func (p Problem) relax(b bounds) ([]rational.Rat, rational.Rat, error) {
+	n := p.A.Cols
+	cols := n
+	for j := range b.hasUpper {
+		if b.hasUpper[j] {
+			if b.upper[j].Cmp(b.lower[j]) < 0 {
+				return nil, rational.Rat{}, ErrInfeasible
+			}
+			cols++
+		}
+	}
+
+	var a [][]rational.Rat
+	var rhs []rational.Rat
+	for i := 0; i < p.A.Rows; i++ {
+		row := make([]rational.Rat, cols)
+		r := p.B[i]
+		for j := 0; j < n; j++ {
+			row[j] = p.A.At(i, j)
+			if !row[j].IsZero() && !b.lower[j].IsZero() {
+				r = r.Sub(row[j].Mul(b.lower[j]))
+			}
+		}
+		a = append(a, row)
+		rhs = append(rhs, r)
+	}
+
+	slack := n
+	for j := 0; j < n; j++ {
+		if !b.hasUpper[j] {
+			continue
+		}
+		row := make([]rational.Rat, cols)
+		row[j] = rational.Int(1)
+		row[slack] = rational.Int(1)
+		slack++
+		a = append(a, row)
+		rhs = append(rhs, b.upper[j].Sub(b.lower[j]))
+	}
+
+	cost := make([]rational.Rat, cols)
+	copy(cost, p.C)
+
+	y, value, err := simplex(a, rhs, cost)
+	if err != nil {
+		return nil, rational.Rat{}, err
+	}
+
+	x := make([]rational.Rat, n)
+	for j := range x {
+		x[j] = b.lower[j].Add(y[j])
+		value = value.Add(p.C[j].Mul(b.lower[j]))
+	}
+	return x, value, nil
+}
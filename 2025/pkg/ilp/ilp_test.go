@@ -0,0 +1,143 @@
+package ilp
+
+import (
+	"errors"
+	"testing"
+
+	"aoc2025/pkg/rational"
+)
+
+func TestMinimize(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        [][]int
+		b, c     []int
+		maxNodes int
+		want     []int64 // optimal x, nil when an error is expected
+		value    int64
+		err      error
+	}{
+		{
+			// The relaxation picks y = 7/3, so branching is needed
+			name:  "feasible optimum",
+			a:     [][]int{{1, 3}},
+			b:     []int{7},
+			c:     []int{1, 1},
+			want:  []int64{1, 2},
+			value: 3,
+		},
+		{
+			name:  "already integral",
+			a:     [][]int{{1, 0}, {0, 1}},
+			b:     []int{4, 5},
+			c:     []int{2, 3},
+			want:  []int64{4, 5},
+			value: 23,
+		},
+		{
+			// Duplicate and zero rows leave artificial variables in the
+			// basis at the end of phase one
+			name:  "redundant rows",
+			a:     [][]int{{1, 1}, {1, 1}, {2, 2}, {0, 0}},
+			b:     []int{2, 2, 4, 0},
+			c:     []int{1, 2},
+			want:  []int64{2, 0},
+			value: 2,
+		},
+		{
+			// Every right-hand side is 0, so phase one starts at its optimum
+			name:  "degenerate",
+			a:     [][]int{{1, -1}, {1, 1}},
+			b:     []int{0, 0},
+			c:     []int{1, 1},
+			want:  []int64{0, 0},
+			value: 0,
+		},
+		{
+			name: "infeasible relaxation",
+			a:    [][]int{{1, 1}},
+			b:    []int{-1},
+			c:    []int{1, 1},
+			err:  ErrInfeasible,
+		},
+		{
+			// Feasible over the rationals, but 2x+2y is always even
+			name: "no integer solution",
+			a:    [][]int{{2, 2}},
+			b:    []int{3},
+			c:    []int{1, 1},
+			err:  ErrInfeasible,
+		},
+		{
+			name: "unbounded",
+			a:    [][]int{{1, -1}},
+			b:    []int{0},
+			c:    []int{-1, 0},
+			err:  ErrUnbounded,
+		},
+		{
+			// 2x-2y=1 has no integer solution, and without a bound on x
+			// branching keeps finding fractional points further out
+			name:     "node limit",
+			a:        [][]int{{2, -2}},
+			b:        []int{1},
+			c:        []int{1, 0},
+			maxNodes: 50,
+			err:      ErrNodeLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := FromInts(tt.a, tt.b, tt.c)
+			p.MaxNodes = tt.maxNodes
+
+			result, err := Minimize(p)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.Value.Equal(rational.Int(tt.value)) {
+				t.Errorf("value = %s, want %d", result.Value, tt.value)
+			}
+			for j, want := range tt.want {
+				if !result.X[j].Equal(rational.Int(want)) {
+					t.Errorf("x[%d] = %s, want %d", j, result.X[j], want)
+				}
+			}
+			if result.Nodes < 1 {
+				t.Errorf("nodes = %d, want at least 1", result.Nodes)
+			}
+		})
+	}
+}
+
+func TestRelax(t *testing.T) {
+	result, err := Relax(FromInts([][]int{{1, 3}}, []int{7}, []int{1, 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := rational.New(7, 3); !result.Value.Equal(want) {
+		t.Errorf("value = %s, want %s", result.Value, want)
+	}
+
+	_, err = Relax(FromInts([][]int{{1, -1}}, []int{0}, []int{-1, 0}))
+	if !errors.Is(err, ErrUnbounded) {
+		t.Errorf("got error %v, want %v", err, ErrUnbounded)
+	}
+}
+
+func TestMinimizeRejectsMismatchedSizes(t *testing.T) {
+	if _, err := Minimize(FromInts([][]int{{1, 1}}, []int{1, 2}, []int{1, 1})); err == nil {
+		t.Error("expected an error for a right-hand side of the wrong length")
+	}
+	if _, err := Minimize(FromInts([][]int{{1, 1}}, []int{1}, []int{1})); err == nil {
+		t.Error("expected an error for an objective of the wrong length")
+	}
+}
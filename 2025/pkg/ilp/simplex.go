@@ -0,0 +1,176 @@
+package ilp
+
+import "aoc2025/pkg/rational"
+
+// simplex minimizes c·x subject to a·x = b and x >= 0 with the two-phase
+// tableau method. Entering and leaving variables are chosen by Bland's rule,
+// which cannot cycle, and all arithmetic is exact.
+func simplex(a [][]rational.Rat, b, c []rational.Rat) ([]rational.Rat, rational.Rat, error) {
+	m, n := len(a), len(c)
+	width := n + m + 1 // variables, one artificial per row, right-hand side
+	rhs := width - 1
+
+	// Phase 1 starts from the artificial variables as the basis and minimizes
+	// their sum, which reaches 0 exactly when the constraints can be met
+	t := &tableau{
+		rows:  make([][]rational.Rat, m),
+		obj:   make([]rational.Rat, width),
+		basis: make([]int, m),
+	}
+	for i := range a {
+		row := make([]rational.Rat, width)
+		copy(row, a[i])
+		row[rhs] = b[i]
+		if b[i].Sign() < 0 {
+			// Artificial variables need a non-negative right-hand side
+			for k := range row {
+				row[k] = row[k].Neg()
+			}
+		}
+		row[n+i] = rational.Int(1)
+		t.rows[i] = row
+		t.basis[i] = n + i
+
+		// Price out the artificial variable so its reduced cost is 0
+		for j := 0; j < n; j++ {
+			t.obj[j] = t.obj[j].Sub(row[j])
+		}
+		t.obj[rhs] = t.obj[rhs].Sub(row[rhs])
+	}
+
+	if err := t.optimize(n); err != nil {
+		return nil, rational.Rat{}, err
+	}
+	if !t.obj[rhs].IsZero() {
+		return nil, rational.Rat{}, ErrInfeasible
+	}
+
+	// Swap the artificial variables left in the basis, all at 0, for real
+	// ones. A row with no real variable left is redundant and dropped.
+	for i := 0; i < len(t.rows); {
+		if t.basis[i] < n {
+			i++
+			continue
+		}
+		col := -1
+		for j := 0; j < n; j++ {
+			if !t.rows[i][j].IsZero() {
+				col = j
+				break
+			}
+		}
+		if col == -1 {
+			t.rows = append(t.rows[:i], t.rows[i+1:]...)
+			t.basis = append(t.basis[:i], t.basis[i+1:]...)
+			continue
+		}
+		t.pivot(i, col)
+		i++
+	}
+
+	// Phase 2 minimizes the real objective from the feasible basis found.
+	// Artificial columns are never allowed back in.
+	t.obj = make([]rational.Rat, width)
+	copy(t.obj, c)
+	for i, row := range t.rows {
+		cost := c[t.basis[i]]
+		if cost.IsZero() {
+			continue
+		}
+		for k := range t.obj {
+			if !row[k].IsZero() {
+				t.obj[k] = t.obj[k].Sub(cost.Mul(row[k]))
+			}
+		}
+	}
+
+	if err := t.optimize(n); err != nil {
+		return nil, rational.Rat{}, err
+	}
+
+	x := make([]rational.Rat, n)
+	for i, row := range t.rows {
+		x[t.basis[i]] = row[rhs]
+	}
+	return x, t.obj[rhs].Neg(), nil
+}
+
+// tableau is a simplex tableau in canonical form
+type tableau struct {
+	rows  [][]rational.Rat // constraint rows, the last entry is the right-hand side
+	obj   []rational.Rat   // reduced costs, the last entry is minus the objective
+	basis []int            // basic variable of each row
+}
+
+// optimize pivots until no column below allowed has a negative reduced cost
+func (t *tableau) optimize(allowed int) error {
+	rhs := len(t.obj) - 1
+	for {
+		// Bland's rule: the lowest improving column enters...
+		col := -1
+		for j := 0; j < allowed; j++ {
+			if t.obj[j].Sign() < 0 {
+				col = j
+				break
+			}
+		}
+		if col == -1 {
+			return nil
+		}
+
+		// ...and among the rows with the smallest ratio, the lowest basic
+		// variable leaves
+		leave := -1
+		var best rational.Rat
+		for i, row := range t.rows {
+			if row[col].Sign() <= 0 {
+				continue
+			}
+			ratio := row[rhs].Div(row[col])
+			if leave == -1 {
+				leave, best = i, ratio
+				continue
+			}
+			if c := ratio.Cmp(best); c < 0 || c == 0 && t.basis[i] < t.basis[leave] {
+				leave, best = i, ratio
+			}
+		}
+		if leave == -1 {
+			return ErrUnbounded
+		}
+
+		t.pivot(leave, col)
+	}
+}
+
+// pivot makes col the basic variable of row r
+func (t *tableau) pivot(r, col int) {
+	row := t.rows[r]
+	if p := row[col]; !p.Equal(rational.Int(1)) {
+		for k := range row {
+			if !row[k].IsZero() {
+				row[k] = row[k].Div(p)
+			}
+		}
+	}
+
+	eliminate := func(other []rational.Rat) {
+		factor := other[col]
+		if factor.IsZero() {
+			return
+		}
+		for k := range other {
+			if !row[k].IsZero() {
+				other[k] = other[k].Sub(factor.Mul(row[k]))
+			}
+		}
+	}
+	for i := range t.rows {
+		if i != r {
+			eliminate(t.rows[i])
+		}
+	}
+	eliminate(t.obj)
+
+	t.basis[r] = col
+}
@@ -0,0 +1,222 @@
+// Package interval stores sets of int64 values as sorted, merged ranges.
+//
+// Every operation is safe for values at the very ends of the int64 range:
+// nothing computes Hi+1 or Lo-1 without checking for overflow first.
+package interval
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// Interval is the inclusive range [Lo, Hi]. It is empty when Lo > Hi.
+type Interval struct {
+	Lo, Hi int64
+}
+
+// Empty reports whether the interval holds no values
+func (iv Interval) Empty() bool {
+	return iv.Lo > iv.Hi
+}
+
+// Contains reports whether x is inside the interval
+func (iv Interval) Contains(x int64) bool {
+	return iv.Lo <= x && x <= iv.Hi
+}
+
+// Len returns the number of values in the interval.
+// The full int64 range holds 2^64 values, one more than uint64 can count,
+// so it saturates at math.MaxUint64.
+func (iv Interval) Len() uint64 {
+	if iv.Empty() {
+		return 0
+	}
+	if iv.Lo == math.MinInt64 && iv.Hi == math.MaxInt64 {
+		return math.MaxUint64
+	}
+	// Two's complement subtraction is exact modulo 2^64
+	return uint64(iv.Hi) - uint64(iv.Lo) + 1
+}
+
+// touches reports whether b starts inside a or right after it, so the two
+// can be merged into one interval. a.Lo must be <= b.Lo.
+func touches(a, b Interval) bool {
+	return a.Hi == math.MaxInt64 || b.Lo <= a.Hi+1
+}
+
+// Merge sorts intervals and merges the ones that overlap or are adjacent.
+// Empty intervals are dropped. The input slice is not modified.
+func Merge(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, iv := range intervals {
+		if !iv.Empty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Lo < sorted[j].Lo
+	})
+
+	merged := make([]Interval, 0, len(sorted))
+	for _, iv := range sorted {
+		if len(merged) == 0 {
+			merged = append(merged, iv)
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+		if touches(*last, iv) {
+			// Merge: extend the end if needed
+			if iv.Hi > last.Hi {
+				last.Hi = iv.Hi
+			}
+		} else {
+			merged = append(merged, iv)
+		}
+	}
+	return merged
+}
+
+// Set is a set of int64 values kept as sorted, disjoint, non-adjacent intervals.
+// The zero value is an empty set.
+type Set struct {
+	intervals []Interval
+}
+
+// New creates a set holding the union of the given intervals
+func New(intervals ...Interval) *Set {
+	return &Set{intervals: Merge(intervals)}
+}
+
+// Intervals returns a copy of the set's intervals in ascending order
+func (s *Set) Intervals() []Interval {
+	return append([]Interval(nil), s.intervals...)
+}
+
+// Insert adds every value in [lo, hi] to the set. Empty ranges are ignored.
+func (s *Set) Insert(lo, hi int64) {
+	iv := Interval{Lo: lo, Hi: hi}
+	if iv.Empty() {
+		return
+	}
+
+	// First interval ending at or after lo-1, the first one iv can merge with
+	start := sort.Search(len(s.intervals), func(i int) bool {
+		hi := s.intervals[i].Hi
+		return hi == math.MaxInt64 || hi+1 >= iv.Lo
+	})
+	end := start
+	for end < len(s.intervals) && touches(iv, s.intervals[end]) {
+		if s.intervals[end].Lo < iv.Lo {
+			iv.Lo = s.intervals[end].Lo
+		}
+		if s.intervals[end].Hi > iv.Hi {
+			iv.Hi = s.intervals[end].Hi
+		}
+		end++
+	}
+
+	// Replace intervals[start:end] with the merged interval
+	s.intervals = append(s.intervals[:start], append([]Interval{iv}, s.intervals[end:]...)...)
+}
+
+// Add inserts an interval, see Insert
+func (s *Set) Add(iv Interval) {
+	s.Insert(iv.Lo, iv.Hi)
+}
+
+// Contains reports whether x is in the set, in O(log n)
+func (s *Set) Contains(x int64) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].Hi >= x
+	})
+	return i < len(s.intervals) && s.intervals[i].Lo <= x
+}
+
+// ContainsInterval reports whether every value of iv is in the set
+func (s *Set) ContainsInterval(iv Interval) bool {
+	if iv.Empty() {
+		return true
+	}
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].Hi >= iv.Lo
+	})
+	return i < len(s.intervals) && s.intervals[i].Lo <= iv.Lo && iv.Hi <= s.intervals[i].Hi
+}
+
+// Len returns the number of values in the set, saturating at math.MaxUint64
+func (s *Set) Len() uint64 {
+	var total uint64
+	for _, iv := range s.intervals {
+		var carry uint64
+		total, carry = bits.Add64(total, iv.Len(), 0)
+		if carry != 0 {
+			return math.MaxUint64
+		}
+	}
+	return total
+}
+
+// Union returns a new set with the values in either set
+func (s *Set) Union(other *Set) *Set {
+	return New(append(s.Intervals(), other.intervals...)...)
+}
+
+// Intersection returns a new set with the values in both sets
+func (s *Set) Intersection(other *Set) *Set {
+	result := &Set{}
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(other.intervals) {
+		a, b := s.intervals[i], other.intervals[j]
+		overlap := Interval{Lo: max(a.Lo, b.Lo), Hi: min(a.Hi, b.Hi)}
+		if !overlap.Empty() {
+			result.intervals = append(result.intervals, overlap)
+		}
+		// Advance whichever interval ends first
+		if a.Hi < b.Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the values in s that are not in other
+func (s *Set) Difference(other *Set) *Set {
+	result := &Set{}
+	j := 0
+	for _, a := range s.intervals {
+		// Skip intervals of other that end before a starts
+		for j < len(other.intervals) && other.intervals[j].Hi < a.Lo {
+			j++
+		}
+
+		lo := a.Lo
+		covered := false
+		for k := j; k < len(other.intervals) && other.intervals[k].Lo <= a.Hi; k++ {
+			b := other.intervals[k]
+			if b.Lo > lo {
+				result.intervals = append(result.intervals, Interval{Lo: lo, Hi: b.Lo - 1})
+			}
+			if b.Hi >= a.Hi {
+				covered = true
+				break
+			}
+			lo = b.Hi + 1 // b.Hi < a.Hi, so this cannot overflow
+		}
+		if !covered {
+			result.intervals = append(result.intervals, Interval{Lo: lo, Hi: a.Hi})
+		}
+	}
+	return result
+}
+
+// Gaps returns the ranges inside within that are not in the set
+func (s *Set) Gaps(within Interval) []Interval {
+	if within.Empty() {
+		return nil
+	}
+	return New(within).Difference(s).intervals
+}
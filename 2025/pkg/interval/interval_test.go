@@ -0,0 +1,160 @@
+package interval
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+const (
+	minInt = math.MinInt64
+	maxInt = math.MaxInt64
+)
+
+func TestInsertAtLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		inserts []Interval
+		want    []Interval
+	}{
+		{
+			name:    "adjacent at the top",
+			inserts: []Interval{{maxInt, maxInt}, {maxInt - 2, maxInt - 1}},
+			want:    []Interval{{maxInt - 2, maxInt}},
+		},
+		{
+			name:    "adjacent at the bottom",
+			inserts: []Interval{{minInt, minInt}, {minInt + 1, minInt + 3}},
+			want:    []Interval{{minInt, minInt + 3}},
+		},
+		{
+			name:    "both ends apart",
+			inserts: []Interval{{maxInt, maxInt}, {minInt, minInt}},
+			want:    []Interval{{minInt, minInt}, {maxInt, maxInt}},
+		},
+		{
+			name:    "joined across zero",
+			inserts: []Interval{{minInt, -1}, {1, maxInt}, {0, 0}},
+			want:    []Interval{{minInt, maxInt}},
+		},
+		{
+			name:    "gap of one at the top",
+			inserts: []Interval{{0, maxInt - 2}, {maxInt, maxInt}},
+			want:    []Interval{{0, maxInt - 2}, {maxInt, maxInt}},
+		},
+		{
+			name:    "empty range",
+			inserts: []Interval{{maxInt, minInt}},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New()
+			for _, iv := range tt.inserts {
+				s.Insert(iv.Lo, iv.Hi)
+			}
+			if got := s.Intervals(); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLenAtLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		set  *Set
+		want uint64
+	}{
+		{"empty", New(), 0},
+		{"single min", New(Interval{minInt, minInt}), 1},
+		{"single max", New(Interval{maxInt, maxInt}), 1},
+		{"non-negative", New(Interval{0, maxInt}), 1 << 63},
+		{"negative", New(Interval{minInt, -1}), 1 << 63},
+		{"all but one", New(Interval{minInt, -1}, Interval{1, maxInt}), math.MaxUint64},
+		{"everything saturates", New(Interval{minInt, maxInt}), math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if got := tt.set.Len(); got != tt.want {
+			t.Errorf("%s: Len() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDifferenceAtLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		s, other []Interval
+		want     []Interval
+	}{
+		{
+			name:  "remove both ends",
+			s:     []Interval{{minInt, maxInt}},
+			other: []Interval{{minInt, minInt}, {maxInt, maxInt}},
+			want:  []Interval{{minInt + 1, maxInt - 1}},
+		},
+		{
+			name:  "remove the middle",
+			s:     []Interval{{minInt, maxInt}},
+			other: []Interval{{0, 0}},
+			want:  []Interval{{minInt, -1}, {1, maxInt}},
+		},
+		{
+			name:  "remove everything",
+			s:     []Interval{{minInt, 0}, {5, maxInt}},
+			other: []Interval{{minInt, maxInt}},
+			want:  nil,
+		},
+		{
+			name:  "other reaches past the top",
+			s:     []Interval{{maxInt - 5, maxInt}},
+			other: []Interval{{maxInt - 3, maxInt}},
+			want:  []Interval{{maxInt - 5, maxInt - 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.s...).Difference(New(tt.other...)).Intervals()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGapsAtLimits(t *testing.T) {
+	s := New(Interval{minInt + 1, -1}, Interval{1, maxInt - 1})
+
+	got := s.Gaps(Interval{minInt, maxInt})
+	want := []Interval{{minInt, minInt}, {0, 0}, {maxInt, maxInt}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Gaps = %v, want %v", got, want)
+	}
+
+	if got := New().Gaps(Interval{minInt, maxInt}); !slices.Equal(got, []Interval{{minInt, maxInt}}) {
+		t.Errorf("Gaps of an empty set = %v, want the whole range", got)
+	}
+	if got := s.Gaps(Interval{maxInt, minInt}); got != nil {
+		t.Errorf("Gaps within an empty range = %v, want none", got)
+	}
+}
+
+func TestContainsAtLimits(t *testing.T) {
+	s := New(Interval{minInt, minInt}, Interval{maxInt, maxInt})
+	for _, x := range []int64{minInt, maxInt} {
+		if !s.Contains(x) {
+			t.Errorf("Contains(%d) = false, want true", x)
+		}
+	}
+	for _, x := range []int64{minInt + 1, 0, maxInt - 1} {
+		if s.Contains(x) {
+			t.Errorf("Contains(%d) = true, want false", x)
+		}
+	}
+	if !New(Interval{minInt, maxInt}).ContainsInterval(Interval{minInt, maxInt}) {
+		t.Error("the full range does not contain itself")
+	}
+}
@@ -0,0 +1,237 @@
+// Package linalg provides exact linear algebra over rational numbers:
+// row reduction, rank, null spaces and solving linear systems.
+package linalg
+
+import (
+	"errors"
+	"fmt"
+
+	"aoc2025/pkg/rational"
+)
+
+// ErrInconsistent is returned by Solve when the system has no solution
+var ErrInconsistent = errors.New("linear system is inconsistent")
+
+// Matrix is a dense Rows x Cols matrix of rationals
+type Matrix struct {
+	Rows  int
+	Cols  int
+	cells []rational.Rat // row-major
+}
+
+// New creates a rows x cols zero matrix
+func New(rows, cols int) *Matrix {
+	return &Matrix{Rows: rows, Cols: cols, cells: make([]rational.Rat, rows*cols)}
+}
+
+// FromInts creates a matrix from rows of integers.
+// Rows shorter than the longest one are padded with zeros.
+func FromInts(rows [][]int) *Matrix {
+	cols := 0
+	for _, row := range rows {
+		cols = max(cols, len(row))
+	}
+
+	m := New(len(rows), cols)
+	for r, row := range rows {
+		for c, v := range row {
+			m.Set(r, c, rational.Int(int64(v)))
+		}
+	}
+	return m
+}
+
+// At returns the entry at row r, column c
+func (m *Matrix) At(r, c int) rational.Rat {
+	return m.cells[m.index(r, c)]
+}
+
+// Set changes the entry at row r, column c
+func (m *Matrix) Set(r, c int, v rational.Rat) {
+	m.cells[m.index(r, c)] = v
+}
+
+func (m *Matrix) index(r, c int) int {
+	if r < 0 || r >= m.Rows || c < 0 || c >= m.Cols {
+		panic(fmt.Sprintf("linalg: index (%d, %d) out of range for %dx%d matrix", r, c, m.Rows, m.Cols))
+	}
+	return r*m.Cols + c
+}
+
+// Row returns a copy of row r
+func (m *Matrix) Row(r int) []rational.Rat {
+	row := make([]rational.Rat, m.Cols)
+	copy(row, m.cells[r*m.Cols:(r+1)*m.Cols])
+	return row
+}
+
+// Clone returns a copy of the matrix
+func (m *Matrix) Clone() *Matrix {
+	cells := make([]rational.Rat, len(m.cells))
+	copy(cells, m.cells) // Rats are immutable, so a shallow copy is enough
+	return &Matrix{Rows: m.Rows, Cols: m.Cols, cells: cells}
+}
+
+func (m *Matrix) swapRows(a, b int) {
+	for c := 0; c < m.Cols; c++ {
+		i, j := a*m.Cols+c, b*m.Cols+c
+		m.cells[i], m.cells[j] = m.cells[j], m.cells[i]
+	}
+}
+
+// RREF returns the reduced row echelon form of m and its pivot columns in
+// increasing order. m itself is left unchanged.
+func (m *Matrix) RREF() (*Matrix, []int) {
+	reduced := m.Clone()
+	pivots := make([]int, 0, min(m.Rows, m.Cols))
+
+	row := 0
+	for col := 0; col < m.Cols && row < m.Rows; col++ {
+		found := -1
+		for r := row; r < m.Rows; r++ {
+			if !reduced.At(r, col).IsZero() {
+				found = r
+				break
+			}
+		}
+		if found == -1 {
+			continue // No pivot in this column
+		}
+
+		reduced.swapRows(row, found)
+		pivots = append(pivots, col)
+
+		// Scale the pivot row so the pivot is 1
+		pivot := reduced.At(row, col)
+		for c := col; c < m.Cols; c++ {
+			reduced.Set(row, c, reduced.At(row, c).Div(pivot))
+		}
+
+		// Clear the column in every other row
+		for r := 0; r < m.Rows; r++ {
+			factor := reduced.At(r, col)
+			if r == row || factor.IsZero() {
+				continue
+			}
+			for c := col; c < m.Cols; c++ {
+				reduced.Set(r, c, reduced.At(r, c).Sub(factor.Mul(reduced.At(row, c))))
+			}
+		}
+		row++
+	}
+
+	return reduced, pivots
+}
+
+// Rank returns the number of linearly independent rows of m
+func (m *Matrix) Rank() int {
+	_, pivots := m.RREF()
+	return len(pivots)
+}
+
+// NullSpace returns a basis of the vectors x with m·x = 0, one vector per
+// free column. An empty result means only the zero vector.
+func (m *Matrix) NullSpace() [][]rational.Rat {
+	reduced, pivots := m.RREF()
+	_, basis := nullSpace(reduced, pivots, m.Cols)
+	return basis
+}
+
+// nullSpace builds the null space basis of a matrix already in RREF over its
+// first cols columns, and returns it together with the free columns
+func nullSpace(reduced *Matrix, pivots []int, cols int) (free []int, basis [][]rational.Rat) {
+	isPivot := make([]bool, cols)
+	for _, col := range pivots {
+		isPivot[col] = true
+	}
+
+	for col := 0; col < cols; col++ {
+		if isPivot[col] {
+			continue
+		}
+		// Setting this free variable to 1 forces each pivot variable to
+		// minus its coefficient in the pivot's row
+		v := make([]rational.Rat, cols)
+		v[col] = rational.Int(1)
+		for row, pivot := range pivots {
+			v[pivot] = reduced.At(row, col).Neg()
+		}
+		free = append(free, col)
+		basis = append(basis, v)
+	}
+	return free, basis
+}
+
+// Solution describes every solution of a linear system A·x = b as
+// Particular + sum(t[j] * Basis[j]) for any values t of the free variables
+type Solution struct {
+	Particular []rational.Rat   // the solution with all free variables 0
+	Pivots     []int            // variables determined by the free ones
+	Free       []int            // variables that can take any value
+	Basis      [][]rational.Rat // Basis[j] is the direction of free variable Free[j]
+}
+
+// Unique reports whether the system has exactly one solution
+func (s Solution) Unique() bool {
+	return len(s.Free) == 0
+}
+
+// At returns the solution with the free variables set to values, given in
+// the order of Free
+func (s Solution) At(values []rational.Rat) []rational.Rat {
+	x := make([]rational.Rat, len(s.Particular))
+	for i := range x {
+		x[i] = s.Value(i, values)
+	}
+	return x
+}
+
+// Value returns variable i of the solution with the free variables set to
+// values. It is cheaper than At when only some variables are needed.
+func (s Solution) Value(i int, values []rational.Rat) rational.Rat {
+	if len(values) != len(s.Free) {
+		panic(fmt.Sprintf("linalg: got %d free values, want %d", len(values), len(s.Free)))
+	}
+
+	v := s.Particular[i]
+	for j, t := range values {
+		if d := s.Basis[j][i]; !d.IsZero() && !t.IsZero() {
+			v = v.Add(t.Mul(d))
+		}
+	}
+	return v
+}
+
+// Solve finds all solutions of a·x = b.
+// It returns ErrInconsistent if there are none.
+func Solve(a *Matrix, b []rational.Rat) (Solution, error) {
+	if len(b) != a.Rows {
+		return Solution{}, fmt.Errorf("linalg: right-hand side has %d entries, want %d", len(b), a.Rows)
+	}
+
+	// Reduce the augmented matrix [a | b]
+	augmented := New(a.Rows, a.Cols+1)
+	for r := 0; r < a.Rows; r++ {
+		copy(augmented.cells[r*augmented.Cols:], a.cells[r*a.Cols:(r+1)*a.Cols])
+		augmented.Set(r, a.Cols, b[r])
+	}
+	reduced, pivots := augmented.RREF()
+
+	// A pivot in the last column means a row reads 0 = 1
+	if len(pivots) > 0 && pivots[len(pivots)-1] == a.Cols {
+		return Solution{}, ErrInconsistent
+	}
+
+	particular := make([]rational.Rat, a.Cols)
+	for row, col := range pivots {
+		particular[col] = reduced.At(row, a.Cols)
+	}
+	free, basis := nullSpace(reduced, pivots, a.Cols)
+
+	return Solution{
+		Particular: particular,
+		Pivots:     pivots,
+		Free:       free,
+		Basis:      basis,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package linalg
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"aoc2025/pkg/rational"
+)
+
+func ints(values ...int64) []rational.Rat {
+	rats := make([]rational.Rat, len(values))
+	for i, v := range values {
+		rats[i] = rational.Int(v)
+	}
+	return rats
+}
+
+// mulVec returns m·x
+func mulVec(m *Matrix, x []rational.Rat) []rational.Rat {
+	result := make([]rational.Rat, m.Rows)
+	for r := range result {
+		for c := 0; c < m.Cols; c++ {
+			result[r] = result[r].Add(m.At(r, c).Mul(x[c]))
+		}
+	}
+	return result
+}
+
+func equal(a, b []rational.Rat) bool {
+	return slices.EqualFunc(a, b, rational.Rat.Equal)
+}
+
+func TestSolveUnique(t *testing.T) {
+	// x + y = 3, x - y = 0 has the fractional solution x = y = 3/2
+	a := FromInts([][]int{{1, 1}, {1, -1}})
+	solution, err := Solve(a, ints(3, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !solution.Unique() {
+		t.Fatalf("free variables %v, want a unique solution", solution.Free)
+	}
+	half := rational.New(3, 2)
+	if want := []rational.Rat{half, half}; !equal(solution.Particular, want) {
+		t.Errorf("solution = %v, want %v", solution.Particular, want)
+	}
+}
+
+func TestSolveSingular(t *testing.T) {
+	// The second row is twice the first
+	a := FromInts([][]int{{1, 2}, {2, 4}})
+	if a.Rank() != 1 {
+		t.Errorf("rank = %d, want 1", a.Rank())
+	}
+
+	if _, err := Solve(a, ints(1, 3)); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("got error %v, want %v", err, ErrInconsistent)
+	}
+
+	solution, err := Solve(a, ints(1, 2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if solution.Unique() || !slices.Equal(solution.Free, []int{1}) || !slices.Equal(solution.Pivots, []int{0}) {
+		t.Fatalf("pivots %v and free %v, want pivots [0] and free [1]", solution.Pivots, solution.Free)
+	}
+}
+
+func TestSolveFreeVariable(t *testing.T) {
+	// x + z = 4, y - z = 1: z is free
+	a := FromInts([][]int{{1, 0, 1}, {0, 1, -1}})
+	b := ints(4, 1)
+	solution, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(solution.Free, []int{2}) {
+		t.Fatalf("free = %v, want [2]", solution.Free)
+	}
+	if want := ints(4, 1, 0); !equal(solution.Particular, want) {
+		t.Errorf("particular = %v, want %v", solution.Particular, want)
+	}
+
+	// Every choice of the free variable solves the system
+	for _, z := range []rational.Rat{rational.Int(0), rational.Int(-3), rational.New(5, 7)} {
+		x := solution.At([]rational.Rat{z})
+		if !equal(mulVec(a, x), b) {
+			t.Errorf("z = %s: a·%v != %v", z, x, b)
+		}
+		if !x[2].Equal(z) {
+			t.Errorf("z = %s: got x[2] = %s", z, x[2])
+		}
+		if v := solution.Value(0, []rational.Rat{z}); !v.Equal(x[0]) {
+			t.Errorf("Value(0) = %s, want %s", v, x[0])
+		}
+	}
+}
+
+func TestNullSpace(t *testing.T) {
+	tests := []struct {
+		name string
+		m    [][]int
+		dim  int
+	}{
+		{"invertible", [][]int{{2, 1}, {1, 1}}, 0},
+		{"singular", [][]int{{1, 2}, {2, 4}}, 1},
+		{"wide", [][]int{{1, 2, 3}, {0, 1, 1}}, 1},
+		{"zero", [][]int{{0, 0, 0}}, 3},
+		{"dependent columns", [][]int{{1, 1, 2, 0}, {0, 1, 1, 1}, {1, 2, 3, 1}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := FromInts(tt.m)
+			basis := m.NullSpace()
+			if len(basis) != tt.dim {
+				t.Fatalf("null space has dimension %d, want %d", len(basis), tt.dim)
+			}
+			if m.Rank()+len(basis) != m.Cols {
+				t.Errorf("rank %d + nullity %d != %d columns", m.Rank(), len(basis), m.Cols)
+			}
+			zero := make([]rational.Rat, m.Rows)
+			for _, v := range basis {
+				if !equal(mulVec(m, v), zero) {
+					t.Errorf("m·%v is not zero", v)
+				}
+			}
+		})
+	}
+}
+
+func TestSolveRejectsWrongLength(t *testing.T) {
+	if _, err := Solve(FromInts([][]int{{1}}), ints(1, 2)); err == nil {
+		t.Error("expected an error for a right-hand side of the wrong length")
+	}
+}
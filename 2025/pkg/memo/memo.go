@@ -0,0 +1,122 @@
+// Package memo caches the results of recursive functions and counts how
+// well the cache works.
+package memo
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// Stats describes how a Memo's cache has been used
+type Stats struct {
+	Hits      int // calls answered from the cache
+	Misses    int // calls that ran the function
+	Size      int // entries currently cached
+	Evictions int // entries dropped to stay within the bound
+}
+
+// HitRate returns the fraction of calls answered from the cache
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// String summarizes the stats on one line, e.g.
+// "1500 hits, 500 misses (75.0% hit rate), 500 entries"
+func (s Stats) String() string {
+	line := fmt.Sprintf("%d hits, %d misses (%.1f%% hit rate), %d entries", s.Hits, s.Misses, 100*s.HitRate(), s.Size)
+	if s.Evictions > 0 {
+		line += fmt.Sprintf(", %d evicted", s.Evictions)
+	}
+	return line
+}
+
+// Memo wraps a function so each key is computed once.
+// The function gets a recurse callback that goes through the cache, so
+// recursive calls are memoized too.
+type Memo[K comparable, V any] struct {
+	fn     func(recurse func(K) V, key K) V
+	values map[K]V
+	stats  Stats
+
+	// Bounded caches only: keys from most to least recently used
+	limit  int
+	recent *list.List
+	elems  map[K]*list.Element
+}
+
+// New memoizes fn with an unbounded cache
+func New[K comparable, V any](fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	return &Memo[K, V]{fn: fn, values: make(map[K]V)}
+}
+
+// NewBounded memoizes fn with a cache of at most limit entries, evicting the
+// least recently used one when it is full
+func NewBounded[K comparable, V any](limit int, fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	if limit < 1 {
+		panic(fmt.Sprintf("memo: cache bound must be positive, got %d", limit))
+	}
+	m := New(fn)
+	m.limit = limit
+	m.recent = list.New()
+	m.elems = make(map[K]*list.Element)
+	return m
+}
+
+// Get returns fn(key), computing it only if it is not cached
+func (m *Memo[K, V]) Get(key K) V {
+	if value, ok := m.values[key]; ok {
+		m.stats.Hits++
+		if m.recent != nil {
+			m.recent.MoveToFront(m.elems[key])
+		}
+		return value
+	}
+
+	m.stats.Misses++
+	value := m.fn(m.Get, key)
+	m.store(key, value)
+	return value
+}
+
+// store caches a computed value. Recursive calls may have cached the same
+// key in the meantime, so it may already be present.
+func (m *Memo[K, V]) store(key K, value V) {
+	_, exists := m.values[key]
+	m.values[key] = value
+	if m.recent == nil {
+		return
+	}
+
+	if exists {
+		m.recent.MoveToFront(m.elems[key])
+		return
+	}
+	m.elems[key] = m.recent.PushFront(key)
+	for len(m.values) > m.limit {
+		oldest := m.recent.Back()
+		m.recent.Remove(oldest)
+		delete(m.values, oldest.Value.(K))
+		delete(m.elems, oldest.Value.(K))
+		m.stats.Evictions++
+	}
+}
+
+// Stats returns the cache counters
+func (m *Memo[K, V]) Stats() Stats {
+	stats := m.stats
+	stats.Size = len(m.values)
+	return stats
+}
+
+// Reset empties the cache and zeroes the counters
+func (m *Memo[K, V]) Reset() {
+	m.values = make(map[K]V)
+	m.stats = Stats{}
+	if m.recent != nil {
+		m.recent.Init()
+		m.elems = make(map[K]*list.Element)
+	}
+}
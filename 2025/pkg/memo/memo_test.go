@@ -0,0 +1,116 @@
+package memo
+
+import "testing"
+
+func fib(recurse func(int) int, n int) int {
+	if n < 2 {
+		return n
+	}
+	return recurse(n-1) + recurse(n-2)
+}
+
+func TestRecursive(t *testing.T) {
+	m := New(fib)
+	if got := m.Get(30); got != 832040 {
+		t.Fatalf("fib(30) = %d, want 832040", got)
+	}
+	// Each of 0..30 runs once; fib(n) for n >= 3 finds fib(n-2) cached
+	if want := (Stats{Hits: 28, Misses: 31, Size: 31}); m.Stats() != want {
+		t.Errorf("got %+v, want %+v", m.Stats(), want)
+	}
+
+	m.Get(30)
+	if stats := m.Stats(); stats.Hits != 29 || stats.Misses != 31 {
+		t.Errorf("repeat call: got %+v, want one more hit", stats)
+	}
+}
+
+func TestBoundedEvictsLeastRecentlyUsed(t *testing.T) {
+	calls := 0
+	m := NewBounded(2, func(_ func(int) int, n int) int {
+		calls++
+		return n * n
+	})
+
+	for _, step := range []struct {
+		key int
+		hit bool
+	}{
+		{1, false}, {2, false},
+		{1, true},  // 1 becomes the most recent
+		{3, false}, // evicts 2
+		{2, false}, // evicts 1
+		{3, true},
+		{1, false}, // evicts 2
+	} {
+		before := calls
+		if got := m.Get(step.key); got != step.key*step.key {
+			t.Fatalf("Get(%d) = %d, want %d", step.key, got, step.key*step.key)
+		}
+		if hit := calls == before; hit != step.hit {
+			t.Errorf("Get(%d): hit %v, want %v", step.key, hit, step.hit)
+		}
+	}
+
+	if want := (Stats{Hits: 2, Misses: 5, Size: 2, Evictions: 3}); m.Stats() != want {
+		t.Errorf("got %+v, want %+v", m.Stats(), want)
+	}
+}
+
+func TestBoundedRecursive(t *testing.T) {
+	for _, limit := range []int{1, 2, 3, 10, 100} {
+		calls := 0
+		m := NewBounded(limit, func(recurse func(int) int, n int) int {
+			calls++
+			return fib(recurse, n)
+		})
+		if got := m.Get(25); got != 75025 {
+			t.Fatalf("limit %d: fib(25) = %d, want 75025", limit, got)
+		}
+
+		stats := m.Stats()
+		if stats.Size > limit || stats.Misses != calls || stats.Misses-stats.Evictions != stats.Size {
+			t.Errorf("limit %d: inconsistent stats %+v after %d calls", limit, stats, calls)
+		}
+		// fib(n-1) touches fib(n-3) after storing fib(n-2), so it takes three
+		// entries to never recompute a value
+		if limit >= 3 && (stats.Misses != 26 || stats.Evictions != 26-min(limit, 26)) {
+			t.Errorf("limit %d: got %+v, want 26 misses", limit, stats)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	m := NewBounded(3, fib)
+	m.Get(10)
+	m.Reset()
+	if m.Stats() != (Stats{}) {
+		t.Fatalf("after Reset: got %+v, want zero stats", m.Stats())
+	}
+	if got := m.Get(10); got != 55 || m.Stats().Misses != 11 {
+		t.Errorf("after Reset: fib(10) = %d with %+v", got, m.Stats())
+	}
+}
+
+func TestNewBoundedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a zero bound")
+		}
+	}()
+	NewBounded(0, fib)
+}
+
+func TestStatsString(t *testing.T) {
+	s := Stats{Hits: 1500, Misses: 500, Size: 500}
+	if got, want := s.String(), "1500 hits, 500 misses (75.0% hit rate), 500 entries"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	s.Evictions = 7
+	if got, want := s.String(), "1500 hits, 500 misses (75.0% hit rate), 500 entries, 7 evicted"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if (Stats{}).HitRate() != 0 {
+		t.Error("HitRate of unused stats should be 0")
+	}
+}
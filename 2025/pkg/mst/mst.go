@@ -0,0 +1,134 @@
+// Package mst builds minimum spanning trees and forests with Kruskal's and
+// Prim's algorithms.
+//
+// Kruskal can read its edges lazily from an iterator, so callers that can
+// generate edges in increasing weight order (see spatial.KDTree.Pairs) never
+// need to materialize a complete graph, and it can stop early for questions
+// like "what is connected after the first k edges".
+package mst
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+
+	"aoc2025/pkg/unionfind"
+)
+
+// Edge is an undirected weighted edge
+type Edge[N comparable] struct {
+	From, To N
+	Weight   int
+}
+
+// Tree is the result of a spanning tree search
+type Tree[N comparable] struct {
+	Edges     []Edge[N]               // tree edges in the order they were added
+	Weight    int                     // sum of the tree edges' weights
+	Last      Edge[N]                 // the last edge added; the one that connected everything when Connected
+	Connected bool                    // whether the tree spans every node
+	Sets      *unionfind.UnionFind[N] // Kruskal only: the components when it stopped
+}
+
+// Options limit how far Kruskal goes. Zero values mean no limit.
+type Options struct {
+	MaxEdges  int // stop after considering this many edges, joining or not
+	MaxUnions int // stop after adding this many tree edges
+}
+
+// Kruskal finds a minimum spanning forest over nodes using the given edges,
+// in any order. Edges of equal weight keep their relative order.
+func Kruskal[N comparable](nodes []N, edges []Edge[N], opts Options) Tree[N] {
+	sorted := slices.Clone(edges)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Weight < sorted[j].Weight
+	})
+	return KruskalSeq(nodes, slices.Values(sorted), opts)
+}
+
+// KruskalSeq is Kruskal over edges that arrive in non-decreasing weight
+// order. It stops reading them as soon as every node is connected or a limit
+// in opts is reached, and panics if a weight goes down.
+func KruskalSeq[N comparable](nodes []N, edges iter.Seq[Edge[N]], opts Options) Tree[N] {
+	sets := unionfind.New[N]()
+	for _, node := range nodes {
+		sets.Add(node)
+	}
+	tree := Tree[N]{Sets: sets, Connected: sets.Count() <= 1}
+	if tree.Connected {
+		return tree
+	}
+
+	considered, prevWeight := 0, 0
+	for edge := range edges {
+		if considered > 0 && edge.Weight < prevWeight {
+			panic(fmt.Sprintf("mst: edge weight %d after %d, edges must come in non-decreasing order", edge.Weight, prevWeight))
+		}
+		considered++
+		prevWeight = edge.Weight
+
+		if sets.Union(edge.From, edge.To) {
+			tree.Edges = append(tree.Edges, edge)
+			tree.Weight += edge.Weight
+			tree.Last = edge
+			if sets.Count() == 1 {
+				tree.Connected = true
+				break
+			}
+			if opts.MaxUnions > 0 && len(tree.Edges) >= opts.MaxUnions {
+				break
+			}
+		}
+		if opts.MaxEdges > 0 && considered >= opts.MaxEdges {
+			break
+		}
+	}
+	return tree
+}
+
+// Prim grows a minimum spanning tree from start over the edges returned by
+// neighbors, whose From is the node asked for. It spans every node reachable
+// from start, so Connected is always true and Sets is nil.
+func Prim[N comparable](start N, neighbors func(N) []Edge[N]) Tree[N] {
+	inTree := map[N]bool{start: true}
+	tree := Tree[N]{Connected: true}
+
+	queue := &edgeQueue[N]{}
+	for _, edge := range neighbors(start) {
+		heap.Push(queue, edge)
+	}
+
+	for queue.Len() > 0 {
+		edge := heap.Pop(queue).(Edge[N])
+		if inTree[edge.To] {
+			continue // stale entry, the node joined through a cheaper edge
+		}
+		inTree[edge.To] = true
+		tree.Edges = append(tree.Edges, edge)
+		tree.Weight += edge.Weight
+		tree.Last = edge
+
+		for _, next := range neighbors(edge.To) {
+			if !inTree[next.To] {
+				heap.Push(queue, next)
+			}
+		}
+	}
+	return tree
+}
+
+// edgeQueue is a binary min-heap of edges by weight, used through container/heap
+type edgeQueue[N comparable] []Edge[N]
+
+func (q edgeQueue[N]) Len() int           { return len(q) }
+func (q edgeQueue[N]) Less(i, j int) bool { return q[i].Weight < q[j].Weight }
+func (q edgeQueue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *edgeQueue[N]) Push(x any)        { *q = append(*q, x.(Edge[N])) }
+func (q *edgeQueue[N]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
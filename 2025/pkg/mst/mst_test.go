@@ -0,0 +1,137 @@
+package mst
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// adjacency returns a neighbors function for Prim over undirected edges
+func adjacency(edges []Edge[string]) func(string) []Edge[string] {
+	adj := map[string][]Edge[string]{}
+	for _, e := range edges {
+		adj[e.From] = append(adj[e.From], e)
+		adj[e.To] = append(adj[e.To], Edge[string]{From: e.To, To: e.From, Weight: e.Weight})
+	}
+	return func(n string) []Edge[string] { return adj[n] }
+}
+
+func TestKruskalMatchesPrim(t *testing.T) {
+	nodes := []string{"a", "b", "c", "d", "e"}
+	edges := []Edge[string]{
+		{"a", "b", 4}, {"a", "c", 1}, {"b", "c", 2}, {"b", "d", 5},
+		{"c", "d", 8}, {"c", "e", 10}, {"d", "e", 2}, {"a", "e", 9},
+	}
+
+	kruskal := Kruskal(nodes, edges, Options{})
+	prim := Prim("a", adjacency(edges))
+	if !kruskal.Connected || kruskal.Weight != 10 || len(kruskal.Edges) != len(nodes)-1 {
+		t.Errorf("Kruskal: connected %v, weight %d, %d edges; want a connected tree of weight 10 with 4 edges",
+			kruskal.Connected, kruskal.Weight, len(kruskal.Edges))
+	}
+	if prim.Weight != kruskal.Weight || len(prim.Edges) != len(kruskal.Edges) {
+		t.Errorf("Prim: weight %d with %d edges, Kruskal: weight %d with %d edges",
+			prim.Weight, len(prim.Edges), kruskal.Weight, len(kruskal.Edges))
+	}
+}
+
+func TestKruskalMatchesPrimRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := range 20 {
+		n := 2 + rng.Intn(12)
+		var nodes []string
+		for i := range n {
+			nodes = append(nodes, string(rune('a'+i)))
+		}
+		var edges []Edge[string]
+		for i := range n {
+			for j := i + 1; j < n; j++ {
+				if rng.Intn(3) > 0 {
+					edges = append(edges, Edge[string]{From: nodes[i], To: nodes[j], Weight: rng.Intn(6)})
+				}
+			}
+		}
+
+		// Prim from one node of each component adds up to Kruskal's forest
+		kruskal := Kruskal(nodes, edges, Options{})
+		neighbors := adjacency(edges)
+		weight, count, components := 0, 0, 0
+		seen := map[string]bool{}
+		for _, node := range nodes {
+			if seen[node] {
+				continue
+			}
+			components++
+			prim := Prim(node, neighbors)
+			weight += prim.Weight
+			count += len(prim.Edges)
+			seen[node] = true
+			for _, e := range prim.Edges {
+				seen[e.To] = true
+			}
+		}
+
+		if kruskal.Weight != weight || len(kruskal.Edges) != count {
+			t.Errorf("round %d: Kruskal weight %d with %d edges, Prim weight %d with %d edges",
+				round, kruskal.Weight, len(kruskal.Edges), weight, count)
+		}
+		if kruskal.Connected != (components == 1) || kruskal.Sets.Count() != components {
+			t.Errorf("round %d: Kruskal connected %v with %d sets, want %d components",
+				round, kruskal.Connected, kruskal.Sets.Count(), components)
+		}
+	}
+}
+
+func TestKruskalDisconnected(t *testing.T) {
+	nodes := []string{"a", "b", "c", "x", "y", "z"}
+	edges := []Edge[string]{
+		{"a", "b", 3}, {"b", "c", 1}, {"a", "c", 2},
+		{"x", "y", 7},
+	}
+
+	forest := Kruskal(nodes, edges, Options{})
+	if forest.Connected {
+		t.Error("forest over three components should not be connected")
+	}
+	if forest.Weight != 10 || len(forest.Edges) != 3 || forest.Sets.Count() != 3 {
+		t.Errorf("got weight %d, %d edges, %d sets; want 10, 3, 3", forest.Weight, len(forest.Edges), forest.Sets.Count())
+	}
+
+	// Prim only spans the start's component
+	left, right := Prim("a", adjacency(edges)), Prim("x", adjacency(edges))
+	if left.Weight+right.Weight != forest.Weight || len(left.Edges)+len(right.Edges) != len(forest.Edges) {
+		t.Errorf("Prim components: weights %d+%d, edges %d+%d; Kruskal: %d, %d",
+			left.Weight, right.Weight, len(left.Edges), len(right.Edges), forest.Weight, len(forest.Edges))
+	}
+	if z := Prim("z", adjacency(edges)); len(z.Edges) != 0 || z.Weight != 0 {
+		t.Errorf("Prim from an isolated node = %+v, want an empty tree", z)
+	}
+}
+
+func TestKruskalLimits(t *testing.T) {
+	nodes := []string{"a", "b", "c", "d"}
+	edges := []Edge[string]{{"a", "b", 1}, {"b", "a", 2}, {"c", "d", 3}, {"b", "c", 4}}
+
+	tree := Kruskal(nodes, edges, Options{MaxEdges: 2})
+	if len(tree.Edges) != 1 || tree.Connected {
+		t.Errorf("MaxEdges 2: got %d tree edges, want 1 and not connected", len(tree.Edges))
+	}
+	tree = Kruskal(nodes, edges, Options{MaxUnions: 2})
+	if want := []Edge[string]{{"a", "b", 1}, {"c", "d", 3}}; !slices.Equal(tree.Edges, want) {
+		t.Errorf("MaxUnions 2: got %v, want %v", tree.Edges, want)
+	}
+	tree = Kruskal(nodes, edges, Options{})
+	if !tree.Connected || tree.Last != (Edge[string]{"b", "c", 4}) {
+		t.Errorf("got last edge %v, connected %v; want b-c connecting everything", tree.Last, tree.Connected)
+	}
+}
+
+func TestKruskalSeqPanicsOnDecreasingWeights(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic when weights go down")
+		}
+	}()
+	edges := []Edge[int]{{0, 1, 5}, {1, 2, 3}}
+	KruskalSeq([]int{0, 1, 2}, slices.Values(edges), Options{})
+}
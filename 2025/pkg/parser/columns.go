@@ -0,0 +1,105 @@
+package parser
+
+import "strings"
+
+// Block is a rectangle of column-aligned text. Whitespace is kept exactly
+// and every row is padded with spaces to the same width, so Rows[r][c] is
+// always valid. Columns are byte columns, which suits the ASCII layouts of
+// puzzle inputs.
+type Block struct {
+	Rows []string // the rows, top to bottom
+	Left int      // the column of the block's first character in the input
+}
+
+// Aligned returns the whole input as a single block, keeping the spaces
+// that other helpers trim and padding short lines on the right. Trailing
+// blank lines are dropped, so the last row is the last line with text.
+func (i *Input) Aligned() *Block {
+	end := len(i.Lines)
+	for end > 0 && strings.TrimSpace(i.Lines[end-1]) == "" {
+		end--
+	}
+
+	lines := make([]string, end)
+	width := 0
+	for idx, line := range i.Lines[:end] {
+		lines[idx] = strings.TrimSuffix(line, "\r")
+		width = max(width, len(lines[idx]))
+	}
+	for idx, line := range lines {
+		lines[idx] = line + strings.Repeat(" ", width-len(line))
+	}
+	return &Block{Rows: lines}
+}
+
+// ColumnBlocks splits the aligned input into blocks wherever a column is
+// blank on every line, e.g. one block per problem in a layout like
+//
+//	123 328
+//	 45 64
+//	*   +
+func (i *Input) ColumnBlocks() []*Block {
+	return i.Aligned().Split()
+}
+
+// Width returns the number of columns
+func (b *Block) Width() int {
+	if len(b.Rows) == 0 {
+		return 0
+	}
+	return len(b.Rows[0])
+}
+
+// Height returns the number of rows
+func (b *Block) Height() int {
+	return len(b.Rows)
+}
+
+// Column returns column c read from top to bottom
+func (b *Block) Column(c int) string {
+	column := make([]byte, len(b.Rows))
+	for r, row := range b.Rows {
+		column[r] = row[c]
+	}
+	return string(column)
+}
+
+// Columns returns every column, left to right, each read from top to bottom.
+// It is the block transposed: Columns()[c][r] == Rows[r][c].
+func (b *Block) Columns() []string {
+	columns := make([]string, b.Width())
+	for c := range columns {
+		columns[c] = b.Column(c)
+	}
+	return columns
+}
+
+// Split cuts the block at every column that is blank on every row, dropping
+// those columns. Blocks come back left to right.
+func (b *Block) Split() []*Block {
+	var blocks []*Block
+	start := -1
+
+	for c := 0; c <= b.Width(); c++ {
+		if c < b.Width() && strings.TrimSpace(b.Column(c)) != "" {
+			if start == -1 {
+				start = c
+			}
+			continue
+		}
+		if start != -1 {
+			blocks = append(blocks, b.cut(start, c))
+			start = -1
+		}
+	}
+	return blocks
+}
+
+// cut returns columns [from, to) as a block of their own
+func (b *Block) cut(from, to int) *Block {
+	rows := make([]string, len(b.Rows))
+	for r, row := range b.Rows {
+		rows[r] = row[from:to]
+	}
+	return &Block{Rows: rows, Left: b.Left + from}
+}
@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAlignedPadsRaggedLines(t *testing.T) {
+	block := FromLines([]string{"12", "3456\r", "", "7"}).Aligned()
+	want := []string{"12  ", "3456", "    ", "7   "}
+	if !slices.Equal(block.Rows, want) {
+		t.Errorf("Rows = %q, want %q", block.Rows, want)
+	}
+	if block.Width() != 4 || block.Height() != 4 || block.Left != 0 {
+		t.Errorf("got %dx%d at column %d, want 4x4 at 0", block.Width(), block.Height(), block.Left)
+	}
+}
+
+func TestAlignedDropsTrailingBlankLines(t *testing.T) {
+	block := FromLines([]string{"1 2", "+ *", "", "   ", "\r"}).Aligned()
+	if want := []string{"1 2", "+ *"}; !slices.Equal(block.Rows, want) {
+		t.Errorf("Rows = %q, want %q", block.Rows, want)
+	}
+
+	if block := FromLines([]string{"", " "}).Aligned(); block.Height() != 0 || block.Width() != 0 {
+		t.Errorf("blank input: got %q, want no rows", block.Rows)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	block := FromLines([]string{"123", " 45", "  6"}).Aligned()
+	if got, want := block.Columns(), []string{"1  ", "24 ", "356"}; !slices.Equal(got, want) {
+		t.Errorf("Columns() = %q, want %q", got, want)
+	}
+	for c := range block.Width() {
+		for r := range block.Height() {
+			if block.Columns()[c][r] != block.Rows[r][c] {
+				t.Fatalf("Columns()[%d][%d] != Rows[%d][%d]", c, r, r, c)
+			}
+		}
+	}
+}
+
+func TestColumnBlocks(t *testing.T) {
+	input := FromLines([]string{
+		"123 328  51 64 ",
+		" 45 64  387 23",
+		"  6 98  215 314",
+		"*   +   *   +  ",
+		"",
+	})
+	blocks := input.ColumnBlocks()
+
+	want := []struct {
+		left int
+		rows []string
+	}{
+		{0, []string{"123", " 45", "  6", "*  "}},
+		{4, []string{"328", "64 ", "98 ", "+  "}},
+		{8, []string{" 51", "387", "215", "*  "}},
+		{12, []string{"64 ", "23 ", "314", "+  "}},
+	}
+	if len(blocks) != len(want) {
+		t.Fatalf("got %d blocks, want %d", len(blocks), len(want))
+	}
+	for i, w := range want {
+		if blocks[i].Left != w.left || !slices.Equal(blocks[i].Rows, w.rows) {
+			t.Errorf("block %d = %q at column %d, want %q at %d", i, blocks[i].Rows, blocks[i].Left, w.rows, w.left)
+		}
+	}
+
+	// Splitting a block again keeps columns relative to the input
+	inner := blocks[2].Split()
+	if len(inner) != 1 || inner[0].Left != 8 {
+		t.Errorf("re-split block 2 = %+v, want one block at column 8", inner)
+	}
+}
+
+func TestSplitEdges(t *testing.T) {
+	// Blank columns at either edge and runs of them produce no empty blocks
+	blocks := FromLines([]string{"  a   b  ", "  a   b  "}).ColumnBlocks()
+	if len(blocks) != 2 || blocks[0].Left != 2 || blocks[1].Left != 6 {
+		t.Errorf("got %d blocks: %+v", len(blocks), blocks)
+	}
+
+	if blocks := FromLines([]string{"   ", ""}).ColumnBlocks(); len(blocks) != 0 {
+		t.Errorf("blank input: got %+v, want no blocks", blocks)
+	}
+}
@@ -0,0 +1,253 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError reports a line that does not match a pattern, or a value in
+// it that does not fit its field
+type SyntaxError struct {
+	Line   int    // 1-based line in the input, 0 when a single line was decoded
+	Column int    // 1-based byte offset in the line where the problem starts
+	Text   string // the whole line
+	Msg    string
+	Err    error // the underlying conversion error, if any
+}
+
+func (e *SyntaxError) Error() string {
+	where := fmt.Sprintf("column %d", e.Column)
+	if e.Line > 0 {
+		where = fmt.Sprintf("line %d, %s", e.Line, where)
+	}
+	return fmt.Sprintf("%s: %s in %q", where, e.Msg, e.Text)
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// Pattern describes the layout of a line, such as "{X},{Y},{Z}" or
+// "move {Count} from {From} to {To}". Text in braces names a struct field
+// and everything else must match literally; "{{" and "}}" stand for literal
+// braces.
+//
+// A field's name in a pattern is its Go name, or the name in an `aoc:"name"`
+// tag. Fields can be any integer, float, bool or string type. A value runs
+// up to the first occurrence of the literal text after it, so two fields
+// must be separated by some literal text.
+type Pattern struct {
+	source string
+	parts  []patternPart
+}
+
+// patternPart is either literal text or a field name
+type patternPart struct {
+	text    string
+	isField bool
+}
+
+// CompilePattern parses a pattern
+func CompilePattern(pattern string) (*Pattern, error) {
+	p := &Pattern{source: pattern}
+	var literal strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case (c == '{' || c == '}') && i+1 < len(pattern) && pattern[i+1] == c:
+			literal.WriteByte(c)
+			i++
+		case c == '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end == -1 {
+				return nil, fmt.Errorf("parser: unclosed { at offset %d in pattern %q", i, pattern)
+			}
+			name := pattern[i+1 : i+end]
+			if name == "" {
+				return nil, fmt.Errorf("parser: empty field name at offset %d in pattern %q", i, pattern)
+			}
+			if literal.Len() == 0 && len(p.parts) > 0 && p.parts[len(p.parts)-1].isField {
+				return nil, fmt.Errorf("parser: fields %s and %s need text between them in pattern %q", p.parts[len(p.parts)-1].text, name, pattern)
+			}
+			if literal.Len() > 0 {
+				p.parts = append(p.parts, patternPart{text: literal.String()})
+				literal.Reset()
+			}
+			p.parts = append(p.parts, patternPart{text: name, isField: true})
+			i += end
+		case c == '}':
+			return nil, fmt.Errorf("parser: unmatched } at offset %d in pattern %q", i, pattern)
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	if literal.Len() > 0 {
+		p.parts = append(p.parts, patternPart{text: literal.String()})
+	}
+	return p, nil
+}
+
+// MustCompilePattern is like CompilePattern but panics on a bad pattern
+func MustCompilePattern(pattern string) *Pattern {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the source of the pattern
+func (p *Pattern) String() string {
+	return p.source
+}
+
+// Decode matches line against the pattern and stores the fields in the
+// struct dst points to
+func (p *Pattern) Decode(line string, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("parser: Decode needs a non-nil pointer to a struct, got %T", dst)
+	}
+	fields, err := p.bind(v.Elem().Type())
+	if err != nil {
+		return err
+	}
+	return p.decode(line, v.Elem(), fields)
+}
+
+// DecodeLines decodes every non-empty line of the input into a T with the
+// pattern. A SyntaxError names the first line that does not fit.
+func DecodeLines[T any](input *Input, pattern string) ([]T, error) {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var zero T
+	t := reflect.TypeOf(zero)
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("parser: DecodeLines needs a struct type, got %T", zero)
+	}
+	fields, err := p.bind(t)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(input.Lines))
+	for idx, line := range input.Lines {
+		if line == "" {
+			continue
+		}
+		var value T
+		if err := p.decode(line, reflect.ValueOf(&value).Elem(), fields); err != nil {
+			return nil, input.atLine(err, idx)
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// bind finds the struct field index of every field part, in pattern order
+func (p *Pattern) bind(t reflect.Type) ([]int, error) {
+	var fields []int
+	for _, part := range p.parts {
+		if !part.isField {
+			continue
+		}
+		index := -1
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if f.Tag.Get("aoc") == part.text || f.Name == part.text {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("parser: pattern field %s not found in %v", part.text, t)
+		}
+		switch t.Field(index).Type.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64, reflect.Bool, reflect.String:
+		default:
+			return nil, fmt.Errorf("parser: field %s of %v has unsupported type %v", part.text, t, t.Field(index).Type)
+		}
+		fields = append(fields, index)
+	}
+	return fields, nil
+}
+
+// decode matches one line, filling the struct fields of dst
+func (p *Pattern) decode(line string, dst reflect.Value, fields []int) error {
+	pos := 0
+	field := 0
+	for i, part := range p.parts {
+		if !part.isField {
+			if !strings.HasPrefix(line[pos:], part.text) {
+				return &SyntaxError{Column: pos + 1, Text: line, Msg: fmt.Sprintf("expected %q", part.text)}
+			}
+			pos += len(part.text)
+			continue
+		}
+
+		// The value runs up to the next literal, or to the end of the line
+		end := len(line)
+		if i+1 < len(p.parts) {
+			next := strings.Index(line[pos:], p.parts[i+1].text)
+			if next == -1 {
+				return &SyntaxError{Column: pos + 1, Text: line, Msg: fmt.Sprintf("expected %q after %s", p.parts[i+1].text, part.text)}
+			}
+			end = pos + next
+		}
+
+		if err := setField(dst.Field(fields[field]), line[pos:end]); err != nil {
+			return &SyntaxError{Column: pos + 1, Text: line, Msg: fmt.Sprintf("invalid %s %q", part.text, line[pos:end]), Err: err}
+		}
+		field++
+		pos = end
+	}
+
+	if pos != len(line) {
+		return &SyntaxError{Column: pos + 1, Text: line, Msg: "unexpected text at end of line"}
+	}
+	return nil
+}
+
+// setField converts text to the field's type and stores it
+func setField(f reflect.Value, text string) error {
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(text, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetFloat(x)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.String:
+		f.SetString(text)
+	}
+	return nil
+}
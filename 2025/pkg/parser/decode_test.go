@@ -0,0 +1,137 @@
+package parser
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type move struct {
+	Count int `aoc:"n"`
+	From  string
+	To    string
+}
+
+func TestCompilePatternErrors(t *testing.T) {
+	for _, pattern := range []string{
+		"{X}{Y}",   // adjacent fields
+		"{X",       // unclosed
+		"X}",       // unmatched
+		"{}",       // empty name
+		"a {X}{Y}", // adjacent after text
+	} {
+		if _, err := CompilePattern(pattern); err == nil {
+			t.Errorf("%q: expected an error", pattern)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		line    string
+		want    move
+	}{
+		{"tag and field names", "move {n} from {From} to {To}", "move 3 from a to b", move{3, "a", "b"}},
+		{"escaped braces", "{{{n}}} {From}", "{12} x", move{Count: 12, From: "x"}},
+		{"escaped braces around a name", "{{n}} {n}", "{n} 7", move{Count: 7}},
+		{"field at the end takes the rest", "{n}:{To}", "5:a:b", move{Count: 5, To: "a:b"}},
+		{"negative number", "{n},{From}", "-4,x", move{Count: -4, From: "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got move
+			if err := MustCompilePattern(tt.pattern).Decode(tt.line, &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		line    string
+		column  int
+		wrapped bool // whether a conversion error is wrapped
+	}{
+		{"wrong literal", "move {n} from {From}", "mov 3 from a", 1, false},
+		{"missing separator", "{n},{From}", "12;x", 1, false},
+		{"not a number", "move {n} from {From}", "move x from a", 6, true},
+		{"trailing text", "({n})", "(5)!", 4, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got move
+			err := MustCompilePattern(tt.pattern).Decode(tt.line, &got)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("got error %v, want a *SyntaxError", err)
+			}
+			if syntaxErr.Line != 0 || syntaxErr.Column != tt.column || syntaxErr.Text != tt.line {
+				t.Errorf("got line %d, column %d, text %q; want line 0, column %d, text %q",
+					syntaxErr.Line, syntaxErr.Column, syntaxErr.Text, tt.column, tt.line)
+			}
+			var numErr *strconv.NumError
+			if got := errors.As(err, &numErr); got != tt.wrapped {
+				t.Errorf("wraps a *strconv.NumError: %v, want %v", got, tt.wrapped)
+			}
+		})
+	}
+}
+
+func TestDecodeLines(t *testing.T) {
+	input := FromLines([]string{"move 1 from a to b", "", "move 2 from b to c"})
+	moves, err := DecodeLines[move](input, "move {n} from {From} to {To}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []move{{1, "a", "b"}, {2, "b", "c"}}
+	if len(moves) != len(want) || moves[0] != want[0] || moves[1] != want[1] {
+		t.Errorf("got %+v, want %+v", moves, want)
+	}
+
+	// The empty line still counts towards the line number
+	input = FromLines([]string{"move 1 from a to b", "", "move two from b to c"})
+	_, err = DecodeLines[move](input, "move {n} from {From} to {To}")
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("got error %v, want a *SyntaxError", err)
+	}
+	if syntaxErr.Line != 3 || syntaxErr.Column != 6 {
+		t.Errorf("got line %d, column %d, want line 3, column 6", syntaxErr.Line, syntaxErr.Column)
+	}
+	if want := `line 3, column 6: invalid n "two" in "move two from b to c"`; err.Error() != want {
+		t.Errorf("got message %q, want %q", err.Error(), want)
+	}
+}
+
+func TestDecodeBindErrors(t *testing.T) {
+	var m move
+	if err := MustCompilePattern("{Missing}").Decode("x", &m); err == nil {
+		t.Error("expected an error for a field the struct lacks")
+	}
+	if err := MustCompilePattern("{n}").Decode("1", m); err == nil {
+		t.Error("expected an error for a non-pointer destination")
+	}
+
+	type unexported struct {
+		n int
+	}
+	if _, err := DecodeLines[unexported](FromLines([]string{"1"}), "{n}"); err == nil {
+		t.Error("expected an error for an unexported field")
+	}
+	type slice struct {
+		N []int
+	}
+	if _, err := DecodeLines[slice](FromLines([]string{"1"}), "{N}"); err == nil {
+		t.Error("expected an error for an unsupported field type")
+	}
+}
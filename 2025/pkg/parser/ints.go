@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Range is an "a-b" token, such as "11-22" or "-5--3"
+type Range struct {
+	Lo, Hi int
+}
+
+// number is a signed integer found in a line, with its byte span
+type number struct {
+	value      int
+	start, end int
+}
+
+// Ints returns every signed integer in s, in order, ignoring the text around
+// them. A '-' directly before a digit is a minus sign unless it follows a
+// digit, so "11-22" is 11 and 22 while "x=-3" is -3.
+func Ints(s string) ([]int, error) {
+	numbers, err := scanNumbers(s)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int, len(numbers))
+	for i, n := range numbers {
+		result[i] = n.value
+	}
+	return result, nil
+}
+
+// Ranges returns every "a-b" token in s, where a single '-' joins two
+// integers that may themselves be negative. Text between tokens is ignored,
+// but a number that is not part of a range is an error, so "5-", "1 - 2"
+// and "1-2-3" are all rejected.
+func Ranges(s string) ([]Range, error) {
+	numbers, err := scanNumbers(s)
+	if err != nil {
+		return nil, err
+	}
+	var result []Range
+	for i := 0; i < len(numbers); i += 2 {
+		lo := numbers[i]
+		if i+1 == len(numbers) || lo.end == len(s) || s[lo.end] != '-' || numbers[i+1].start != lo.end+1 {
+			return nil, &SyntaxError{Column: lo.start + 1, Text: s, Msg: fmt.Sprintf("%q is not part of a range", s[lo.start:lo.end])}
+		}
+		result = append(result, Range{Lo: lo.value, Hi: numbers[i+1].value})
+	}
+	return result, nil
+}
+
+// Ints returns every signed integer in the input, across all lines
+func (i *Input) Ints() ([]int, error) {
+	var result []int
+	for idx, line := range i.Lines {
+		nums, err := Ints(line)
+		if err != nil {
+			return nil, i.atLine(err, idx)
+		}
+		result = append(result, nums...)
+	}
+	return result, nil
+}
+
+// LineInts returns the signed integers on each line; row i holds the
+// numbers on Lines[i] and is empty for a line without any
+func (i *Input) LineInts() ([][]int, error) {
+	result := make([][]int, len(i.Lines))
+	for idx, line := range i.Lines {
+		nums, err := Ints(line)
+		if err != nil {
+			return nil, i.atLine(err, idx)
+		}
+		result[idx] = nums
+	}
+	return result, nil
+}
+
+// Ranges returns every "a-b" token in the input, across all lines
+func (i *Input) Ranges() ([]Range, error) {
+	var result []Range
+	for idx, line := range i.Lines {
+		ranges, err := Ranges(line)
+		if err != nil {
+			return nil, i.atLine(err, idx)
+		}
+		result = append(result, ranges...)
+	}
+	return result, nil
+}
+
+// LineRanges returns the "a-b" tokens on each line, aligned with Lines
+func (i *Input) LineRanges() ([][]Range, error) {
+	result := make([][]Range, len(i.Lines))
+	for idx, line := range i.Lines {
+		ranges, err := Ranges(line)
+		if err != nil {
+			return nil, i.atLine(err, idx)
+		}
+		result[idx] = ranges
+	}
+	return result, nil
+}
+
+// scanNumbers finds the signed integers in s. The only possible error is a
+// number too large for an int.
+func scanNumbers(s string) ([]number, error) {
+	var numbers []number
+	for pos := 0; pos < len(s); {
+		start := pos
+		if s[pos] == '-' && pos+1 < len(s) && isDigit(s[pos+1]) && (pos == 0 || !isDigit(s[pos-1])) {
+			pos++ // a minus sign rather than a separator
+		} else if !isDigit(s[pos]) {
+			pos++
+			continue
+		}
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+
+		value, err := strconv.Atoi(s[start:pos])
+		if err != nil {
+			return nil, &SyntaxError{Column: start + 1, Text: s, Msg: fmt.Sprintf("invalid integer %q", s[start:pos]), Err: err}
+		}
+		numbers = append(numbers, number{value: value, start: start, end: pos})
+	}
+	return numbers, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// atLine stores the 1-based line number of Lines[idx] on a SyntaxError
+// from a single line, counted in the whole input for a section
+func (i *Input) atLine(err error, idx int) error {
+	var syntaxErr *SyntaxError
+	if errors.As(err, &syntaxErr) {
+		syntaxErr.Line = i.offset + idx + 1
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int
+	}{
+		{"", nil},
+		{"no numbers", nil},
+		{"{3,-5,4}", []int{3, -5, 4}},
+		{"11-22", []int{11, 22}},    // '-' after a digit separates
+		{"x=-3, y=4", []int{-3, 4}}, // '-' after text is a sign
+		{"-5--3", []int{-5, -3}},    // the second '-' follows a separator
+		{"a - 2", []int{2}},         // '-' before a space is neither
+		{"1--2", []int{1, -2}},      // separator then sign
+		{"007,-0", []int{7, 0}},     // leading zeros and negative zero
+		{"p=1,2 v=-3,-4", []int{1, 2, -3, -4}},
+	}
+	for _, tt := range tests {
+		got, err := Ints(tt.s)
+		if err != nil {
+			t.Errorf("Ints(%q): unexpected error: %v", tt.s, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Ints(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIntsOverflow(t *testing.T) {
+	_, err := Ints("ok 1, then 99999999999999999999")
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("got %v, want a *SyntaxError", err)
+	}
+	if syntaxErr.Column != 12 || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("got %v at column %d, want a range error at column 12", err, syntaxErr.Column)
+	}
+}
+
+func TestRanges(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []Range
+	}{
+		{"", nil},
+		{"11-22,95-115", []Range{{11, 22}, {95, 115}}},
+		{"-5--3", []Range{{-5, -3}}},
+		{"3--1", []Range{{3, -1}}},
+		{"11-22,", []Range{{11, 22}}},
+		{"from 1-2 to 3-4", []Range{{1, 2}, {3, 4}}},
+	}
+	for _, tt := range tests {
+		got, err := Ranges(tt.s)
+		if err != nil {
+			t.Errorf("Ranges(%q): unexpected error: %v", tt.s, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Ranges(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestRangesRejectsStrayNumbers(t *testing.T) {
+	tests := []struct {
+		s      string
+		column int
+	}{
+		{"5", 1},
+		{"11-22,95", 7},  // a lone number after a range
+		{"11-22,95-", 7}, // a range missing its end
+		{"11-22,x-5", 8}, // "-5" is a negative number, not an end
+		{"1-2-3", 5},     // only one '-' joins a range
+		{"1 - 2", 1},     // the ends must touch the '-'
+	}
+	for _, tt := range tests {
+		got, err := Ranges(tt.s)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Ranges(%q) = %v, %v; want a *SyntaxError", tt.s, got, err)
+			continue
+		}
+		if syntaxErr.Column != tt.column {
+			t.Errorf("Ranges(%q): error at column %d, want %d", tt.s, syntaxErr.Column, tt.column)
+		}
+	}
+}
+
+func TestInputRangesReportsLine(t *testing.T) {
+	input := FromLines([]string{"1-2", "3-4,5"})
+	_, err := input.Ranges()
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Line != 2 {
+		t.Fatalf("got %v, want a *SyntaxError on line 2", err)
+	}
+
+	rows, err := FromLines([]string{"1-2", "", "3-4 5-6"}).LineRanges()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 3 || len(rows[1]) != 0 || !slices.Equal(rows[2], []Range{{3, 4}, {5, 6}}) {
+		t.Errorf("LineRanges() = %v", rows)
+	}
+}
+
+func TestLineInts(t *testing.T) {
+	rows, err := FromLines([]string{"1 2", "", "-3"}).LineInts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 3 || !slices.Equal(rows[0], []int{1, 2}) || len(rows[1]) != 0 || !slices.Equal(rows[2], []int{-3}) {
+		t.Errorf("LineInts() = %v", rows)
+	}
+}
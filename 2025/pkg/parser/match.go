@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Match is one match of a regular expression with its named captures
+type Match struct {
+	Text    string            // the whole match
+	Offset  int               // byte offset of the match in the searched text
+	Line    int               // 0-based line the match starts on
+	Strings map[string]string // every named capture that took part in the match
+	Ints    map[string]int    // the named captures that are valid integers
+}
+
+// Has reports whether the named capture took part in the match, which
+// tells the alternatives of a pattern like `(?P<on>do\(\))|(?P<off>don't\(\))`
+// apart
+func (m Match) Has(name string) bool {
+	_, ok := m.Strings[name]
+	return ok
+}
+
+// FindAll returns every match of re in s, in order.
+// Compile re once, e.g. as a package variable, and reuse it for every line.
+func FindAll(re *regexp.Regexp, s string) []Match {
+	return findAll(re, s, nil)
+}
+
+// Matches returns every match of re in the raw input, which may span lines.
+// Offsets are into Raw and Line is the index into Lines; a match in the
+// trailing newlines, which Lines leaves out, counts as on the last line.
+func (i *Input) Matches(re *regexp.Regexp) []Match {
+	starts := lineStarts(i.Raw)
+	if len(i.Lines) > 0 && len(starts) > len(i.Lines) {
+		starts = starts[:len(i.Lines)]
+	}
+	return findAll(re, i.Raw, starts)
+}
+
+// MatchesOf is Matches for an expression given as a string, compiled once
+// for the whole input
+func (i *Input) MatchesOf(expr string) ([]Match, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return i.Matches(re), nil
+}
+
+// findAll collects the matches of re in s. starts holds the offset of each
+// line in s; nil means s is a single line.
+func findAll(re *regexp.Regexp, s string, starts []int) []Match {
+	names := re.SubexpNames()
+	var matches []Match
+
+	for _, loc := range re.FindAllStringSubmatchIndex(s, -1) {
+		m := Match{
+			Text:    s[loc[0]:loc[1]],
+			Offset:  loc[0],
+			Strings: make(map[string]string),
+			Ints:    make(map[string]int),
+		}
+		if starts != nil {
+			// The last line starting at or before the match
+			m.Line = sort.SearchInts(starts, loc[0]+1) - 1
+		}
+
+		for group, name := range names {
+			if name == "" || loc[2*group] < 0 {
+				continue // unnamed, or not part of this match
+			}
+			value := s[loc[2*group]:loc[2*group+1]]
+			m.Strings[name] = value
+			if n, err := strconv.Atoi(value); err == nil {
+				m.Ints[name] = n
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// lineStarts returns the offset of the first byte of every line in s
+func lineStarts(s string) []int {
+	starts := []int{0}
+	for offset := 0; ; {
+		next := strings.IndexByte(s[offset:], '\n')
+		if next == -1 {
+			return starts
+		}
+		offset += next + 1
+		starts = append(starts, offset)
+	}
+}
@@ -0,0 +1,109 @@
+package parser
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestMatchesAcrossLines(t *testing.T) {
+	input := FromLines([]string{"mul(2,4)x", "", "ab mul(3,", "5) mul(10,1)"})
+	re := regexp.MustCompile(`mul\((?P<left>\d+),\s*(?P<right>\d+)\)`)
+
+	want := []struct {
+		text         string
+		offset, line int
+		left, right  int
+	}{
+		{"mul(2,4)", 0, 0, 2, 4},
+		{"mul(3,\n5)", 14, 2, 3, 5}, // starts on one line and ends on the next
+		{"mul(10,1)", 24, 3, 10, 1},
+	}
+	got := input.Matches(re)
+	if len(got) != len(want) {
+		t.Fatalf("got %d matches, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		m := got[i]
+		if m.Text != w.text || m.Offset != w.offset || m.Line != w.line {
+			t.Errorf("match %d = %q at offset %d on line %d, want %q at %d on line %d",
+				i, m.Text, m.Offset, m.Line, w.text, w.offset, w.line)
+		}
+		if input.Raw[m.Offset:m.Offset+len(m.Text)] != m.Text {
+			t.Errorf("match %d: Offset %d does not point at %q in Raw", i, m.Offset, m.Text)
+		}
+		if m.Ints["left"] != w.left || m.Ints["right"] != w.right {
+			t.Errorf("match %d: Ints = %v, want left=%d right=%d", i, m.Ints, w.left, w.right)
+		}
+	}
+}
+
+func TestMatchCaptures(t *testing.T) {
+	re := regexp.MustCompile(`(?P<on>do\(\))|(?P<off>don't\(\))|(?P<name>[a-z]+)=(?P<value>-?\w+)`)
+	matches := FindAll(re, "x=-12 do() y=0x1F don't() z=")
+
+	if len(matches) != 4 {
+		t.Fatalf("got %d matches, want 4: %+v", len(matches), matches)
+	}
+
+	// A capture that parses as an int lands in both maps
+	if m := matches[0]; m.Strings["value"] != "-12" || m.Ints["value"] != -12 || m.Has("on") {
+		t.Errorf("x=-12: got %+v", m)
+	}
+
+	// Alternatives that did not take part are missing rather than empty
+	if m := matches[1]; !m.Has("on") || m.Has("off") || m.Has("name") || m.Offset != 6 {
+		t.Errorf("do(): got %+v", m)
+	}
+
+	// A capture that is not an integer is only in Strings
+	if m := matches[2]; m.Strings["value"] != "0x1F" || len(m.Ints) != 0 {
+		t.Errorf("y=0x1F: got %+v", m)
+	}
+
+	if m := matches[3]; !m.Has("off") || m.Line != 0 {
+		t.Errorf("don't(): got %+v", m)
+	}
+}
+
+func TestEmptyMatches(t *testing.T) {
+	// An optional capture can take part with an empty value
+	re := regexp.MustCompile(`#(?P<tag>\d*)`)
+	matches := FindAll(re, "# #7")
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches, want 2", len(matches))
+	}
+	if m := matches[0]; !m.Has("tag") || m.Strings["tag"] != "" || len(m.Ints) != 0 {
+		t.Errorf("empty capture: got %+v", m)
+	}
+
+	// Empty matches at every line start and end are reported, including the
+	// end, which for an input is still on its last line
+	input := FromLines([]string{"ab", "c"})
+	wantLines := map[int]int{0: 0, 2: 0, 3: 1, 4: 1, 5: 1}
+	empty := input.Matches(regexp.MustCompile(`(?m)^|$`))
+	if len(empty) != len(wantLines) {
+		t.Errorf("got %d empty matches, want %d", len(empty), len(wantLines))
+	}
+	for _, m := range empty {
+		if m.Text != "" {
+			t.Errorf("got non-empty match %q", m.Text)
+		}
+		if line, ok := wantLines[m.Offset]; !ok || m.Line != line {
+			t.Errorf("empty match at offset %d on line %d, want line %d", m.Offset, m.Line, line)
+		}
+	}
+
+	if got := FindAll(re, ""); len(got) != 0 {
+		t.Errorf("FindAll on an empty string = %+v, want none", got)
+	}
+}
+
+func TestMatchesOf(t *testing.T) {
+	if _, err := FromLines([]string{"a"}).MatchesOf(`(`); err == nil {
+		t.Error("MatchesOf with an invalid expression should fail")
+	}
+	matches, err := FromLines([]string{"a1", "b22"}).MatchesOf(`(?P<n>\d+)`)
+	if err != nil || len(matches) != 2 || matches[1].Ints["n"] != 22 || matches[1].Line != 1 {
+		t.Errorf("MatchesOf = %+v, %v", matches, err)
+	}
+}
@@ -2,15 +2,23 @@ package parser
 
 import (
 	"bufio"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 )
 
+// InputDir is the directory puzzle inputs are read from.
+// It is relative to the working directory, which is the project root when
+// running cmd/aoc; tests running from a package directory point it elsewhere.
+var InputDir = "inputs"
+
 // Input holds the raw and parsed input data
 type Input struct {
 	Raw   string
 	Lines []string
+
+	offset int // lines before Lines[0] in the input a section was cut from
 }
 
 // ReadInput reads the input file for a given day
@@ -39,12 +47,20 @@ func ReadFile(path string) (*Input, error) {
 	}, nil
 }
 
+// FromLines builds an Input from lines that were read some other way
+func FromLines(lines []string) *Input {
+	return &Input{
+		Raw:   strings.Join(lines, "\n") + "\n",
+		Lines: lines,
+	}
+}
+
 func dayFilePath(day int) string {
-	return "inputs/day" + strconv.Itoa(day) + ".txt"
+	return InputDir + "/day" + strconv.Itoa(day) + ".txt"
 }
 
 func exampleFilePath(day int) string {
-	return "inputs/day" + strconv.Itoa(day) + "_example.txt"
+	return InputDir + "/day" + strconv.Itoa(day) + "_example.txt"
 }
 
 // ToInts converts lines to integers
@@ -116,6 +132,46 @@ func (i *Input) SplitByEmptyLine() [][]string {
 	return result
 }
 
+// Sections splits the input into blocks separated by blank lines, each as
+// its own Input. Lines holding only whitespace count as blank, and runs of
+// them, including at the start and end, never produce empty sections.
+// Syntax errors from a section report line numbers in the whole input.
+func (i *Input) Sections() []*Input {
+	var sections []*Input
+	start := -1
+
+	for idx, line := range i.Lines {
+		if strings.TrimSpace(line) == "" {
+			if start != -1 {
+				sections = append(sections, i.section(start, idx))
+				start = -1
+			}
+		} else if start == -1 {
+			start = idx
+		}
+	}
+	if start != -1 {
+		sections = append(sections, i.section(start, len(i.Lines)))
+	}
+	return sections
+}
+
+// section returns Lines[start:end] as an Input that knows where it starts
+func (i *Input) section(start, end int) *Input {
+	section := FromLines(i.Lines[start:end:end])
+	section.offset = i.offset + start
+	return section
+}
+
+// SectionsN is Sections for an input that must have exactly n sections
+func (i *Input) SectionsN(n int) ([]*Input, error) {
+	sections := i.Sections()
+	if len(sections) != n {
+		return nil, fmt.Errorf("parser: expected %d sections separated by blank lines, found %d", n, len(sections))
+	}
+	return sections, nil
+}
+
 // ParseWithDelimiter splits each line by a custom delimiter
 func (i *Input) ParseWithDelimiter(delim string) [][]string {
 	result := make([][]string, 0, len(i.Lines))
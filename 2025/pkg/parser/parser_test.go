@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	input := FromLines([]string{"", "a", "b", "  ", "", "c", "\t", "d", "e", ""})
+	sections := input.Sections()
+
+	want := [][]string{{"a", "b"}, {"c"}, {"d", "e"}}
+	if len(sections) != len(want) {
+		t.Fatalf("got %d sections, want %d", len(sections), len(want))
+	}
+	for i, w := range want {
+		if !slices.Equal(sections[i].Lines, w) {
+			t.Errorf("section %d = %q, want %q", i, sections[i].Lines, w)
+		}
+	}
+
+	// Appending to a section must not overwrite the next one
+	sections[0].Lines = append(sections[0].Lines, "x")
+	if input.Lines[3] != "  " {
+		t.Errorf("appending to a section changed the input: %q", input.Lines)
+	}
+
+	if got := FromLines([]string{"", " "}).Sections(); len(got) != 0 {
+		t.Errorf("blank input: got %d sections, want none", len(got))
+	}
+}
+
+func TestSectionsN(t *testing.T) {
+	input := FromLines([]string{"1", "", "2"})
+	if sections, err := input.SectionsN(2); err != nil || len(sections) != 2 {
+		t.Errorf("SectionsN(2) = %d sections, %v", len(sections), err)
+	}
+	if _, err := input.SectionsN(3); err == nil {
+		t.Error("SectionsN(3) on two sections should fail")
+	}
+}
+
+func TestSectionErrorsReportInputLines(t *testing.T) {
+	input := FromLines([]string{
+		"1-2",
+		"",
+		"move 1 from a to b",
+		"move x from b to c", // line 4
+		"",
+		"",
+		"5 99999999999999999999", // line 7
+	})
+	sections, err := input.SectionsN(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkLine := func(name string, err error, want int) {
+		t.Helper()
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("%s: got %v, want a *SyntaxError", name, err)
+		} else if syntaxErr.Line != want {
+			t.Errorf("%s: error on line %d, want %d", name, syntaxErr.Line, want)
+		}
+	}
+
+	_, err = DecodeLines[move](sections[1], "move {n} from {From} to {To}")
+	checkLine("DecodeLines", err, 4)
+	_, err = sections[2].Ints()
+	checkLine("Ints", err, 7)
+	_, err = sections[2].LineInts()
+	checkLine("LineInts", err, 7)
+
+	// Sections of a section still count from the top of the input
+	nested := sections[1].Sections()
+	_, err = DecodeLines[move](nested[0], "move {n} from {From} to {To}")
+	checkLine("nested DecodeLines", err, 4)
+}
@@ -0,0 +1,62 @@
+package prefix
+
+import (
+	"fmt"
+
+	"aoc2025/pkg/utils"
+)
+
+// Diff2D collects rectangle updates on a width x height grid in constant
+// time each, then produces the resulting grid in one linear pass
+type Diff2D[T Number] struct {
+	width, height int
+	diff          []T // (width+1) x (height+1), the extra row and column absorb the far corners
+}
+
+// NewDiff2D creates an all-zero width x height difference array
+func NewDiff2D[T Number](width, height int) *Diff2D[T] {
+	return &Diff2D[T]{width: width, height: height, diff: make([]T, (width+1)*(height+1))}
+}
+
+// Add adds v to every cell from (x0, y0) to (x1, y1), both corners included
+// and given in any order. It panics if a corner is out of bounds.
+func (d *Diff2D[T]) Add(x0, y0, x1, y1 int, v T) {
+	x0, x1 = min(x0, x1), max(x0, x1)
+	y0, y1 = min(y0, y1), max(y0, y1)
+	if x0 < 0 || y0 < 0 || x1 >= d.width || y1 >= d.height {
+		panic(fmt.Sprintf("prefix: rectangle (%d,%d)-(%d,%d) out of bounds for %dx%d grid", x0, y0, x1, y1, d.width, d.height))
+	}
+
+	stride := d.width + 1
+	d.diff[y0*stride+x0] += v
+	d.diff[y0*stride+x1+1] -= v
+	d.diff[(y1+1)*stride+x0] -= v
+	d.diff[(y1+1)*stride+x1+1] += v
+}
+
+// Grid returns the value of every cell after all the updates so far
+func (d *Diff2D[T]) Grid() *utils.Grid[T] {
+	var zero T
+	g := utils.NewGrid(d.width, d.height, zero)
+	stride := d.width + 1
+
+	// A running 2D prefix sum of the differences is the value of each cell
+	running := make([]T, len(d.diff))
+	for y := 0; y < d.height; y++ {
+		for x := 0; x < d.width; x++ {
+			v := d.diff[y*stride+x]
+			if x > 0 {
+				v += running[y*stride+x-1]
+			}
+			if y > 0 {
+				v += running[(y-1)*stride+x]
+			}
+			if x > 0 && y > 0 {
+				v -= running[(y-1)*stride+x-1]
+			}
+			running[y*stride+x] = v
+			g.Set(utils.Point2D{X: x, Y: y}, v)
+		}
+	}
+	return g
+}
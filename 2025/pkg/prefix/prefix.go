@@ -0,0 +1,150 @@
+// Package prefix provides summed-area tables, which answer "what is the sum
+// over this rectangle" in constant time after a linear-time build, and
+// difference arrays, which do the reverse for range updates.
+package prefix
+
+import (
+	"fmt"
+
+	"aoc2025/pkg/utils"
+)
+
+// Number is any type that can be summed
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Sum2D is a summed-area table over a width x height grid
+type Sum2D[T Number] struct {
+	width, height int
+	sums          []T // (width+1) x (height+1), with a zero first row and column
+}
+
+// Build2D creates a table over a width x height grid whose cell (x, y) holds
+// value(x, y). This suits compressed grids and cells computed on the fly.
+func Build2D[T Number](width, height int, value func(x, y int) T) *Sum2D[T] {
+	s := &Sum2D[T]{width: width, height: height, sums: make([]T, (width+1)*(height+1))}
+	stride := width + 1
+	for y := 1; y <= height; y++ {
+		for x := 1; x <= width; x++ {
+			s.sums[y*stride+x] = value(x-1, y-1) + s.sums[(y-1)*stride+x] + s.sums[y*stride+x-1] - s.sums[(y-1)*stride+x-1]
+		}
+	}
+	return s
+}
+
+// New2D creates a table over rows of values.
+// Rows shorter than the longest one are padded with zeros.
+func New2D[T Number](rows [][]T) *Sum2D[T] {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	return Build2D(width, len(rows), func(x, y int) T {
+		if x < len(rows[y]) {
+			return rows[y][x]
+		}
+		return 0
+	})
+}
+
+// FromGrid creates a table over the cells of g
+func FromGrid[T Number](g *utils.Grid[T]) *Sum2D[T] {
+	return Build2D(g.Width, g.Height, func(x, y int) T {
+		return g.At(utils.Point2D{X: x, Y: y})
+	})
+}
+
+// Count creates a table counting the cells of a width x height grid for
+// which match is true, e.g. the walls of a Grid[rune]
+func Count(width, height int, match func(x, y int) bool) *Sum2D[int] {
+	return Build2D(width, height, func(x, y int) int {
+		if match(x, y) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// Width returns the width of the summed grid
+func (s *Sum2D[T]) Width() int {
+	return s.width
+}
+
+// Height returns the height of the summed grid
+func (s *Sum2D[T]) Height() int {
+	return s.height
+}
+
+// Sum returns the total of the cells from (x0, y0) to (x1, y1), both
+// corners included and given in any order. It panics if a corner is out
+// of bounds.
+func (s *Sum2D[T]) Sum(x0, y0, x1, y1 int) T {
+	x0, x1 = min(x0, x1), max(x0, x1)
+	y0, y1 = min(y0, y1), max(y0, y1)
+	if x0 < 0 || y0 < 0 || x1 >= s.width || y1 >= s.height {
+		panic(fmt.Sprintf("prefix: rectangle (%d,%d)-(%d,%d) out of bounds for %dx%d grid", x0, y0, x1, y1, s.width, s.height))
+	}
+
+	stride := s.width + 1
+	return s.sums[(y1+1)*stride+x1+1] - s.sums[y0*stride+x1+1] - s.sums[(y1+1)*stride+x0] + s.sums[y0*stride+x0]
+}
+
+// SumRect returns the total of the cells in the rectangle with corners a
+// and b, both included
+func (s *Sum2D[T]) SumRect(a, b utils.Point2D) T {
+	return s.Sum(a.X, a.Y, b.X, b.Y)
+}
+
+// Sum3D is a summed-volume table over a width x height x depth grid
+type Sum3D[T Number] struct {
+	width, height, depth int
+	sums                 []T // (width+1) x (height+1) x (depth+1), zero on the low faces
+}
+
+// Build3D creates a table whose cell (x, y, z) holds value(x, y, z)
+func Build3D[T Number](width, height, depth int, value func(x, y, z int) T) *Sum3D[T] {
+	s := &Sum3D[T]{width: width, height: height, depth: depth, sums: make([]T, (width+1)*(height+1)*(depth+1))}
+	for z := 1; z <= depth; z++ {
+		for y := 1; y <= height; y++ {
+			for x := 1; x <= width; x++ {
+				// Inclusion-exclusion over the three lower neighbors and
+				// their overlaps
+				s.sums[s.index(x, y, z)] = value(x-1, y-1, z-1) +
+					s.at(x-1, y, z) + s.at(x, y-1, z) + s.at(x, y, z-1) -
+					s.at(x-1, y-1, z) - s.at(x-1, y, z-1) - s.at(x, y-1, z-1) +
+					s.at(x-1, y-1, z-1)
+			}
+		}
+	}
+	return s
+}
+
+func (s *Sum3D[T]) index(x, y, z int) int {
+	return (z*(s.height+1)+y)*(s.width+1) + x
+}
+
+func (s *Sum3D[T]) at(x, y, z int) T {
+	return s.sums[s.index(x, y, z)]
+}
+
+// Sum returns the total of the cells in the box from (x0, y0, z0) to
+// (x1, y1, z1), both corners included and given in any order. It panics if
+// a corner is out of bounds.
+func (s *Sum3D[T]) Sum(x0, y0, z0, x1, y1, z1 int) T {
+	x0, x1 = min(x0, x1), max(x0, x1)
+	y0, y1 = min(y0, y1), max(y0, y1)
+	z0, z1 = min(z0, z1), max(z0, z1)
+	if x0 < 0 || y0 < 0 || z0 < 0 || x1 >= s.width || y1 >= s.height || z1 >= s.depth {
+		panic(fmt.Sprintf("prefix: box (%d,%d,%d)-(%d,%d,%d) out of bounds for %dx%dx%d grid",
+			x0, y0, z0, x1, y1, z1, s.width, s.height, s.depth))
+	}
+
+	x1, y1, z1 = x1+1, y1+1, z1+1
+	return s.at(x1, y1, z1) -
+		s.at(x0, y1, z1) - s.at(x1, y0, z1) - s.at(x1, y1, z0) +
+		s.at(x0, y0, z1) + s.at(x0, y1, z0) + s.at(x1, y0, z0) -
+		s.at(x0, y0, z0)
+}
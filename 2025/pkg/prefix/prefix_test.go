@@ -0,0 +1,192 @@
+package prefix
+
+import (
+	"math/rand"
+	"testing"
+
+	"aoc2025/pkg/utils"
+)
+
+func randomRows(rng *rand.Rand, width, height int) [][]int {
+	rows := make([][]int, height)
+	for y := range rows {
+		rows[y] = make([]int, width)
+		for x := range rows[y] {
+			rows[y][x] = rng.Intn(21) - 10
+		}
+	}
+	return rows
+}
+
+// naiveSum adds up the cells of rows in an inclusive rectangle
+func naiveSum(rows [][]int, x0, y0, x1, y1 int) int {
+	total := 0
+	for y := y0; y <= y1; y++ {
+		for x := x0; x <= x1; x++ {
+			total += rows[y][x]
+		}
+	}
+	return total
+}
+
+// checkAllRects compares Sum with naiveSum over every rectangle, with the
+// corners given in both orders
+func checkAllRects(t *testing.T, sums *Sum2D[int], rows [][]int) {
+	t.Helper()
+	for y0 := range sums.Height() {
+		for y1 := y0; y1 < sums.Height(); y1++ {
+			for x0 := range sums.Width() {
+				for x1 := x0; x1 < sums.Width(); x1++ {
+					want := naiveSum(rows, x0, y0, x1, y1)
+					if got := sums.Sum(x0, y0, x1, y1); got != want {
+						t.Fatalf("Sum(%d,%d,%d,%d) = %d, want %d", x0, y0, x1, y1, got, want)
+					}
+					if got := sums.Sum(x1, y1, x0, y0); got != want {
+						t.Fatalf("Sum(%d,%d,%d,%d) = %d, want %d", x1, y1, x0, y0, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSum1D(t *testing.T) {
+	// A single row is a one-dimensional prefix sum
+	rng := rand.New(rand.NewSource(1))
+	rows := randomRows(rng, 12, 1)
+	checkAllRects(t, New2D(rows), rows)
+}
+
+func TestSum2D(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, size := range [][2]int{{1, 1}, {5, 3}, {3, 5}, {8, 8}} {
+		rows := randomRows(rng, size[0], size[1])
+		sums := New2D(rows)
+		checkAllRects(t, sums, rows)
+
+		grid := utils.NewGrid(size[0], size[1], 0)
+		for y, row := range rows {
+			for x, v := range row {
+				grid.Set(utils.Point2D{X: x, Y: y}, v)
+			}
+		}
+		checkAllRects(t, FromGrid(grid), rows)
+		if got, want := sums.SumRect(utils.Point2D{X: size[0] - 1, Y: 0}, utils.Point2D{X: 0, Y: size[1] - 1}), naiveSum(rows, 0, 0, size[0]-1, size[1]-1); got != want {
+			t.Errorf("SumRect over the whole %dx%d grid = %d, want %d", size[0], size[1], got, want)
+		}
+	}
+}
+
+func TestNew2DPadsRaggedRows(t *testing.T) {
+	sums := New2D([][]int{{1, 2, 3}, {4}, {}})
+	if sums.Width() != 3 || sums.Height() != 3 {
+		t.Fatalf("got %dx%d, want 3x3", sums.Width(), sums.Height())
+	}
+	checkAllRects(t, sums, [][]int{{1, 2, 3}, {4, 0, 0}, {0, 0, 0}})
+}
+
+func TestCount(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	rows := randomRows(rng, 7, 6)
+	counts := Count(7, 6, func(x, y int) bool { return rows[y][x] > 0 })
+
+	positive := make([][]int, len(rows))
+	for y, row := range rows {
+		positive[y] = make([]int, len(row))
+		for x, v := range row {
+			if v > 0 {
+				positive[y][x] = 1
+			}
+		}
+	}
+	checkAllRects(t, counts, positive)
+}
+
+func TestSum3D(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	const width, height, depth = 4, 3, 5
+	var cells [depth][height][width]int
+	for z := range depth {
+		for y := range height {
+			for x := range width {
+				cells[z][y][x] = rng.Intn(21) - 10
+			}
+		}
+	}
+	sums := Build3D(width, height, depth, func(x, y, z int) int { return cells[z][y][x] })
+
+	for z0 := range depth {
+		for z1 := z0; z1 < depth; z1++ {
+			for y0 := range height {
+				for y1 := y0; y1 < height; y1++ {
+					for x0 := range width {
+						for x1 := x0; x1 < width; x1++ {
+							want := 0
+							for z := z0; z <= z1; z++ {
+								for y := y0; y <= y1; y++ {
+									for x := x0; x <= x1; x++ {
+										want += cells[z][y][x]
+									}
+								}
+							}
+							if got := sums.Sum(x1, y0, z1, x0, y1, z0); got != want {
+								t.Fatalf("Sum over (%d,%d,%d)-(%d,%d,%d) = %d, want %d", x0, y0, z0, x1, y1, z1, got, want)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDiff2DRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	const width, height = 9, 6
+	diff := NewDiff2D[int](width, height)
+	want := make([][]int, height)
+	for y := range want {
+		want[y] = make([]int, width)
+	}
+
+	for range 40 {
+		x0, x1 := rng.Intn(width), rng.Intn(width)
+		y0, y1 := rng.Intn(height), rng.Intn(height)
+		v := rng.Intn(11) - 5
+		diff.Add(x0, y0, x1, y1, v)
+		for y := min(y0, y1); y <= max(y0, y1); y++ {
+			for x := min(x0, x1); x <= max(x0, x1); x++ {
+				want[y][x] += v
+			}
+		}
+	}
+
+	grid := diff.Grid()
+	for y := range height {
+		for x := range width {
+			if got := grid.At(utils.Point2D{X: x, Y: y}); got != want[y][x] {
+				t.Fatalf("cell (%d,%d) = %d, want %d", x, y, got, want[y][x])
+			}
+		}
+	}
+
+	// Summing the updated grid agrees with summing the naive one
+	checkAllRects(t, FromGrid(grid), want)
+}
+
+func TestOutOfBoundsPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"Sum2D":  func() { New2D([][]int{{1, 2}}).Sum(0, 0, 2, 0) },
+		"Sum3D":  func() { Build3D(2, 2, 2, func(x, y, z int) int { return 1 }).Sum(-1, 0, 0, 1, 1, 1) },
+		"Diff2D": func() { NewDiff2D[int](2, 2).Add(0, 0, 1, 2, 1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic for an out of bounds corner", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
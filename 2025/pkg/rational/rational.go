@@ -0,0 +1,300 @@
+// Package rational provides exact rational numbers.
+//
+// Values are kept as reduced int64 fractions while they fit, which is fast
+// and allocation free. An operation that would overflow switches that result
+// to math/big, so results are always exact.
+package rational
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+// Rat is an exact rational number. The zero value is 0.
+// Rats are immutable; every operation returns a new value.
+type Rat struct {
+	// Small form, used when big is nil: reduced, den > 0.
+	// den == 0 stands for den == 1 so the zero value is valid.
+	num, den int64
+	big      *big.Rat // never modified once set
+}
+
+// Int returns the integer n as a Rat
+func Int(n int64) Rat {
+	return normalize(n, 1)
+}
+
+// New returns num/den. It panics if den is zero.
+func New(num, den int64) Rat {
+	if den == 0 {
+		panic("rational: zero denominator")
+	}
+	return normalize(num, den)
+}
+
+// FromBig returns the value of r as a Rat. r is copied.
+func FromBig(r *big.Rat) Rat {
+	return fromBig(new(big.Rat).Set(r))
+}
+
+// fromBig takes ownership of r and uses the small form when it fits
+func fromBig(r *big.Rat) Rat {
+	if r.Num().IsInt64() && r.Denom().IsInt64() {
+		num, den := r.Num().Int64(), r.Denom().Int64()
+		if num != math.MinInt64 && den != math.MinInt64 {
+			return Rat{num: num, den: den}
+		}
+	}
+	return Rat{big: r}
+}
+
+// normalize reduces num/den and moves the sign to the numerator
+func normalize(num, den int64) Rat {
+	if num == math.MinInt64 || den == math.MinInt64 {
+		// Negating these overflows, let big.Rat deal with them
+		return fromBig(new(big.Rat).SetFrac(big.NewInt(num), big.NewInt(den)))
+	}
+	if den < 0 {
+		num, den = -num, -den
+	}
+	if den == 1 {
+		return Rat{num: num, den: 1}
+	}
+	if g := gcd(abs(num), den); g > 1 {
+		num /= g
+		den /= g
+	}
+	return Rat{num: num, den: den}
+}
+
+func (a Rat) parts() (num, den int64) {
+	if a.den == 0 {
+		return a.num, 1
+	}
+	return a.num, a.den
+}
+
+// Big returns the value as a new big.Rat
+func (a Rat) Big() *big.Rat {
+	if a.big != nil {
+		return new(big.Rat).Set(a.big)
+	}
+	num, den := a.parts()
+	return new(big.Rat).SetFrac(big.NewInt(num), big.NewInt(den))
+}
+
+func (a Rat) isBig() bool {
+	return a.big != nil
+}
+
+// Add returns a + b
+func (a Rat) Add(b Rat) Rat {
+	if !a.isBig() && !b.isBig() {
+		an, ad := a.parts()
+		bn, bd := b.parts()
+		if ad == 1 && bd == 1 {
+			if sum, ok := add(an, bn); ok {
+				return Rat{num: sum, den: 1}
+			}
+		}
+		g := gcd(ad, bd)
+		// a/ad + b/bd = (an*(bd/g) + bn*(ad/g)) / (ad/g*bd)
+		x, ok1 := mul(an, bd/g)
+		y, ok2 := mul(bn, ad/g)
+		num, ok3 := add(x, y)
+		den, ok4 := mul(ad/g, bd)
+		if ok1 && ok2 && ok3 && ok4 {
+			return normalize(num, den)
+		}
+	}
+	return fromBig(new(big.Rat).Add(a.Big(), b.Big()))
+}
+
+// Sub returns a - b
+func (a Rat) Sub(b Rat) Rat {
+	return a.Add(b.Neg())
+}
+
+// Mul returns a * b
+func (a Rat) Mul(b Rat) Rat {
+	if !a.isBig() && !b.isBig() {
+		an, ad := a.parts()
+		bn, bd := b.parts()
+		if ad == 1 && bd == 1 {
+			if product, ok := mul(an, bn); ok {
+				return Rat{num: product, den: 1}
+			}
+		}
+		// Cross-reduce first to keep the intermediate products small
+		g1 := gcd(abs(an), bd)
+		g2 := gcd(abs(bn), ad)
+		if g1 == 0 {
+			g1 = 1
+		}
+		if g2 == 0 {
+			g2 = 1
+		}
+		num, ok1 := mul(an/g1, bn/g2)
+		den, ok2 := mul(ad/g2, bd/g1)
+		if ok1 && ok2 {
+			return normalize(num, den)
+		}
+	}
+	return fromBig(new(big.Rat).Mul(a.Big(), b.Big()))
+}
+
+// Div returns a / b. It panics if b is zero.
+func (a Rat) Div(b Rat) Rat {
+	return a.Mul(b.Inv())
+}
+
+// Inv returns 1 / a. It panics if a is zero.
+func (a Rat) Inv() Rat {
+	if a.IsZero() {
+		panic("rational: division by zero")
+	}
+	if a.isBig() {
+		return fromBig(new(big.Rat).Inv(a.big))
+	}
+	num, den := a.parts()
+	return normalize(den, num)
+}
+
+// Neg returns -a
+func (a Rat) Neg() Rat {
+	if a.isBig() {
+		return fromBig(new(big.Rat).Neg(a.big))
+	}
+	num, den := a.parts()
+	return normalize(-num, den) // normalize keeps MinInt64 out of the small form
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of a
+func (a Rat) Sign() int {
+	if a.isBig() {
+		return a.big.Sign()
+	}
+	switch {
+	case a.num < 0:
+		return -1
+	case a.num > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether a == 0
+func (a Rat) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Cmp returns -1, 0 or 1 for a < b, a == b and a > b
+func (a Rat) Cmp(b Rat) int {
+	return a.Sub(b).Sign()
+}
+
+// Equal reports whether a == b
+func (a Rat) Equal(b Rat) bool {
+	return a.Cmp(b) == 0
+}
+
+// IsInt reports whether a is an integer
+func (a Rat) IsInt() bool {
+	if a.isBig() {
+		return a.big.IsInt()
+	}
+	_, den := a.parts()
+	return den == 1
+}
+
+// Int64 returns a as an int64, and false if a is not an integer or does not fit
+func (a Rat) Int64() (int64, bool) {
+	if a.isBig() {
+		if !a.big.IsInt() || !a.big.Num().IsInt64() {
+			return 0, false
+		}
+		return a.big.Num().Int64(), true
+	}
+	num, den := a.parts()
+	if den != 1 {
+		return 0, false
+	}
+	return num, true
+}
+
+// Floor returns the largest integer <= a
+func (a Rat) Floor() Rat {
+	if a.isBig() {
+		q := new(big.Int)
+		m := new(big.Int)
+		// Euclidean division rounds towards -inf for a positive denominator
+		q.DivMod(a.big.Num(), a.big.Denom(), m)
+		return fromBig(new(big.Rat).SetInt(q))
+	}
+	num, den := a.parts()
+	q := num / den
+	if num%den != 0 && num < 0 {
+		q--
+	}
+	return Int(q)
+}
+
+// Ceil returns the smallest integer >= a
+func (a Rat) Ceil() Rat {
+	return a.Neg().Floor().Neg()
+}
+
+// String formats a as "num/den", or just "num" for integers
+func (a Rat) String() string {
+	if a.isBig() {
+		return a.big.RatString()
+	}
+	num, den := a.parts()
+	if den == 1 {
+		return strconv.FormatInt(num, 10)
+	}
+	return strconv.FormatInt(num, 10) + "/" + strconv.FormatInt(den, 10)
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// gcd of two non-negative numbers
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// add returns a + b and whether it fit in an int64
+func add(a, b int64) (int64, bool) {
+	sum := a + b
+	// Overflow happened if both operands have the same sign and the sum's differs
+	if (a >= 0) == (b >= 0) && (sum >= 0) != (a >= 0) {
+		return 0, false
+	}
+	return sum, sum != math.MinInt64
+}
+
+// mul returns a * b and whether it fit in an int64
+func mul(a, b int64) (int64, bool) {
+	if a == math.MinInt64 || b == math.MinInt64 {
+		return 0, false
+	}
+	hi, lo := bits.Mul64(uint64(abs(a)), uint64(abs(b)))
+	if hi != 0 || lo > math.MaxInt64 {
+		return 0, false
+	}
+	product := int64(lo)
+	if (a < 0) != (b < 0) {
+		product = -product
+	}
+	return product, true
+}
@@ -0,0 +1,83 @@
+package rational
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// bigInt returns n as a big.Rat, for expected values past the int64 range
+func bigInt(n int64) *big.Rat {
+	return new(big.Rat).SetInt64(n)
+}
+
+// TestInt64Limits checks that arithmetic on the extreme int64 values stays
+// exact by comparing every result with math/big
+func TestInt64Limits(t *testing.T) {
+	const minInt, maxInt = math.MinInt64, math.MaxInt64
+
+	tests := []struct {
+		name string
+		got  Rat
+		want *big.Rat
+	}{
+		{"neg min", Int(minInt).Neg(), new(big.Rat).Neg(bigInt(minInt))},
+		{"neg max", Int(maxInt).Neg(), bigInt(-maxInt)},
+		{"neg neg min", Int(minInt).Neg().Neg(), bigInt(minInt)},
+		{"zero minus min", Int(0).Sub(Int(minInt)), new(big.Rat).Neg(bigInt(minInt))},
+		{"min minus one", Int(minInt).Sub(Int(1)), new(big.Rat).Sub(bigInt(minInt), bigInt(1))},
+		{"max plus one", Int(maxInt).Add(Int(1)), new(big.Rat).Add(bigInt(maxInt), bigInt(1))},
+		{"min plus max", Int(minInt).Add(Int(maxInt)), bigInt(-1)},
+		{"max minus min", Int(maxInt).Sub(Int(minInt)), new(big.Rat).Sub(bigInt(maxInt), bigInt(minInt))},
+		{"min times minus one", Int(minInt).Mul(Int(-1)), new(big.Rat).Neg(bigInt(minInt))},
+		{"max times max", Int(maxInt).Mul(Int(maxInt)), new(big.Rat).Mul(bigInt(maxInt), bigInt(maxInt))},
+		{"min times half", Int(minInt).Mul(New(1, 2)), bigInt(minInt / 2)},
+		{"inv min", Int(minInt).Inv(), new(big.Rat).Inv(bigInt(minInt))},
+		{"inv max", Int(maxInt).Inv(), new(big.Rat).Inv(bigInt(maxInt))},
+		{"inv of inv min", Int(minInt).Inv().Inv(), bigInt(minInt)},
+		{"one over min", New(1, minInt), new(big.Rat).SetFrac(big.NewInt(1), big.NewInt(minInt))},
+		{"floor min", Int(minInt).Floor(), bigInt(minInt)},
+		{"floor max", Int(maxInt).Floor(), bigInt(maxInt)},
+		{"floor min over two plus half", Int(minInt).Add(New(1, 2)).Floor(), bigInt(minInt)},
+		{"floor max minus half", Int(maxInt).Sub(New(1, 2)).Floor(), bigInt(maxInt - 1)},
+		{"floor below min", Int(minInt).Sub(New(1, 2)).Floor(), new(big.Rat).Sub(bigInt(minInt), bigInt(1))},
+		{"ceil min minus half", Int(minInt).Sub(New(1, 2)).Ceil(), bigInt(minInt)},
+		{"ceil max plus half", Int(maxInt).Add(New(1, 2)).Ceil(), new(big.Rat).Add(bigInt(maxInt), bigInt(1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Big().Cmp(tt.want) != 0 {
+				t.Errorf("got %s, want %s", tt.got, tt.want.RatString())
+			}
+		})
+	}
+}
+
+// TestSmallFormNeverHoldsMinInt64 checks the invariant Neg relies on
+func TestSmallFormNeverHoldsMinInt64(t *testing.T) {
+	for _, r := range []Rat{Int(math.MinInt64), New(math.MinInt64, 1), New(math.MinInt64, 3), FromBig(bigInt(math.MinInt64))} {
+		if !r.isBig() && r.num == math.MinInt64 {
+			t.Errorf("%s is stored in the small form", r)
+		}
+	}
+}
+
+func TestInt64(t *testing.T) {
+	tests := []struct {
+		r    Rat
+		want int64
+		ok   bool
+	}{
+		{Int(math.MinInt64), math.MinInt64, true},
+		{Int(math.MaxInt64), math.MaxInt64, true},
+		{Int(math.MaxInt64).Add(Int(1)), 0, false},
+		{New(1, 2), 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.r.Int64()
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s.Int64() = %d, %v, want %d, %v", tt.r, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"math/big"
+	"strconv"
+)
+
+type answerKind int
+
+const (
+	kindNone answerKind = iota
+	kindInt
+	kindBig
+	kindString
+)
+
+// Answer is the typed result of one puzzle part.
+// It holds an int, int64, *big.Int or string; the zero value is "no answer".
+type Answer struct {
+	kind answerKind
+	n    int64
+	big  *big.Int
+	s    string
+}
+
+// Int wraps an int answer
+func Int(n int) Answer {
+	return Answer{kind: kindInt, n: int64(n)}
+}
+
+// Int64 wraps an int64 answer
+func Int64(n int64) Answer {
+	return Answer{kind: kindInt, n: n}
+}
+
+// BigInt wraps a big.Int answer. The value is copied.
+func BigInt(n *big.Int) Answer {
+	return Answer{kind: kindBig, big: new(big.Int).Set(n)}
+}
+
+// String wraps a string answer
+func String(s string) Answer {
+	return Answer{kind: kindString, s: s}
+}
+
+// IsZero reports whether the answer holds no value
+func (a Answer) IsZero() bool {
+	return a.kind == kindNone
+}
+
+// String formats the answer the way it would be submitted
+func (a Answer) String() string {
+	switch a.kind {
+	case kindInt:
+		return strconv.FormatInt(a.n, 10)
+	case kindBig:
+		return a.big.String()
+	case kindString:
+		return a.s
+	}
+	return ""
+}
+
+// Equal reports whether two answers would be submitted as the same text,
+// so Int(42), Int64(42) and String("42") are all equal.
+func (a Answer) Equal(other Answer) bool {
+	return a.kind != kindNone && other.kind != kindNone && a.String() == other.String()
+}
@@ -0,0 +1,99 @@
+package runner
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Answers maps a part number to its expected answer
+type Answers map[int]Answer
+
+// ReadAnswers reads an answers file.
+//
+// Each non-empty line holds one part and lines starting with # are comments,
+// for example:
+//
+//	# from the puzzle text
+//	part1: 1158
+//	part2: 6860
+func ReadAnswers(path string) (Answers, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	answers := Answers{}
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || !strings.HasPrefix(key, "part") {
+			return nil, fmt.Errorf("%s:%d: expected \"partN: answer\", got %q", path, lineNum, line)
+		}
+		part, err := strconv.Atoi(strings.TrimPrefix(key, "part"))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid part %q", path, lineNum, key)
+		}
+		answers[part] = String(strings.TrimSpace(value))
+	}
+	return answers, scanner.Err()
+}
+
+// WriteAnswers writes answers in the format read by ReadAnswers
+func WriteAnswers(path string, answers Answers) error {
+	var sb strings.Builder
+	for _, part := range answers.Parts() {
+		fmt.Fprintf(&sb, "part%d: %s\n", part, answers[part])
+	}
+	return os.WriteFile(path, []byte(sb.String()), 0o644)
+}
+
+// Parts returns the part numbers that have an answer, in order
+func (a Answers) Parts() []int {
+	parts := make([]int, 0, len(a))
+	for part := range a {
+		parts = append(parts, part)
+	}
+	sort.Ints(parts)
+	return parts
+}
+
+// Diff lists the parts where got differs from want, one line per part.
+// It returns an empty string when both hold the same answers.
+func (a Answers) Diff(got Answers) string {
+	seen := map[int]bool{}
+	var parts []int
+	for _, part := range append(a.Parts(), got.Parts()...) {
+		if !seen[part] {
+			seen[part] = true
+			parts = append(parts, part)
+		}
+	}
+	sort.Ints(parts)
+
+	var sb strings.Builder
+	for _, part := range parts {
+		want, hasWant := a[part]
+		answer, hasGot := got[part]
+		switch {
+		case !hasWant:
+			fmt.Fprintf(&sb, "part%d: unexpected answer %s\n", part, answer)
+		case !hasGot:
+			fmt.Fprintf(&sb, "part%d: missing, want %s\n", part, want)
+		case !want.Equal(answer):
+			fmt.Fprintf(&sb, "part%d: -%s +%s\n", part, want, answer)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,121 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"time"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/trace"
+)
+
+// BenchResult holds the timing of one part over several runs
+type BenchResult struct {
+	Day    int           `json:"day"`
+	Part   int           `json:"part"`
+	Runs   int           `json:"runs"`
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	Allocs uint64        `json:"allocs_per_run"`
+	Bytes  uint64        `json:"bytes_per_run"`
+}
+
+// Key identifies the part a result belongs to, e.g. "day07/part2"
+func (r BenchResult) Key() string {
+	return fmt.Sprintf("day%02d/part%d", r.Day, r.Part)
+}
+
+// Bench solves one part of a day on its real input runs times.
+// The input is read again before every run, outside the timed section, because
+// some solvers modify it. Solvers report through the trace package, whose
+// records go to traceOut as a tree; a nil traceOut turns tracing off, which
+// is what timings should normally measure.
+func Bench(day Day, part int, runs int, traceOut io.Writer) (BenchResult, error) {
+	if runs < 1 {
+		return BenchResult{}, fmt.Errorf("need at least one run, got %d", runs)
+	}
+
+	trace.Enable(traceOut, trace.Tree)
+	defer trace.Enable(nil, trace.Off)
+
+	durations := make([]time.Duration, 0, runs)
+	var allocs, bytes uint64
+	var before, after runtime.MemStats
+
+	for i := 0; i < runs; i++ {
+		input, err := parser.ReadInput(day.Number)
+		if err != nil {
+			return BenchResult{}, err
+		}
+
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		_, err = day.Solve(part, input)
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		if err != nil {
+			return BenchResult{}, err
+		}
+
+		durations = append(durations, elapsed)
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+	}
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	return BenchResult{
+		Day:    day.Number,
+		Part:   part,
+		Runs:   runs,
+		Min:    durations[0],
+		Median: durations[len(durations)/2],
+		Allocs: allocs / uint64(runs),
+		Bytes:  bytes / uint64(runs),
+	}, nil
+}
+
+// SaveBaseline writes bench results to a JSON file
+func SaveBaseline(path string, results []BenchResult) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// LoadBaseline reads bench results saved by SaveBaseline, keyed by BenchResult.Key
+func LoadBaseline(path string) (map[string]BenchResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []BenchResult
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+
+	baseline := make(map[string]BenchResult, len(results))
+	for _, r := range results {
+		baseline[r.Key()] = r
+	}
+	return baseline, nil
+}
+
+// Slowdown returns how much slower the median of r is than the baseline's, in percent.
+// ok is false when the baseline has no entry for the part.
+func (r BenchResult) Slowdown(baseline map[string]BenchResult) (percent float64, ok bool) {
+	base, ok := baseline[r.Key()]
+	if !ok || base.Median <= 0 {
+		return 0, false
+	}
+	return (float64(r.Median)/float64(base.Median) - 1) * 100, true
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc2025/pkg/parser"
+	"aoc2025/pkg/trace"
+)
+
+func TestBenchSendsTraceToWriter(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "day99.txt"), []byte("1\n2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(old string) { parser.InputDir = old }(parser.InputDir)
+	parser.InputDir = dir
+
+	stdout := os.Stdout
+	calls := 0
+	solve := func(input *parser.Input) (Answer, error) {
+		calls++
+		if os.Stdout != stdout {
+			t.Error("Bench replaced os.Stdout")
+		}
+		trace.Event("solving", "lines", len(input.Lines))
+		return Int(len(input.Lines)), nil
+	}
+	day := Day{Number: 99, Solution: Funcs(solve, solve)}
+
+	var out bytes.Buffer
+	result, err := Bench(day, 2, 3, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || result.Runs != 3 || result.Key() != "day99/part2" || result.Min > result.Median {
+		t.Errorf("got %+v after %d calls", result, calls)
+	}
+	if got := strings.Count(out.String(), "· solving lines=2\n"); got != 3 {
+		t.Errorf("trace output has %d events, want 3:\n%s", got, out.String())
+	}
+	if trace.Enabled() {
+		t.Error("tracing is still on after Bench")
+	}
+
+	// Without a writer the solver's trace calls go nowhere
+	out.Reset()
+	if _, err := Bench(day, 1, 1, nil); err != nil || out.Len() != 0 {
+		t.Errorf("Bench without a writer: %v, wrote %q", err, out.String())
+	}
+}
+
+func TestBaselineRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bench.json")
+	results := []BenchResult{
+		{Day: 1, Part: 1, Runs: 5, Min: time.Millisecond, Median: 2 * time.Millisecond},
+		{Day: 7, Part: 2, Runs: 5, Min: 0, Median: 0},
+	}
+	if err := SaveBaseline(path, results); err != nil {
+		t.Fatal(err)
+	}
+	baseline, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baseline["day01/part1"] != results[0] || len(baseline) != 2 {
+		t.Errorf("loaded %+v", baseline)
+	}
+
+	slower := BenchResult{Day: 1, Part: 1, Median: 3 * time.Millisecond}
+	if percent, ok := slower.Slowdown(baseline); !ok || percent != 50 {
+		t.Errorf("Slowdown = %v, %v; want 50, true", percent, ok)
+	}
+	for _, r := range []BenchResult{{Day: 2, Part: 1}, {Day: 7, Part: 2, Median: time.Second}} {
+		if _, ok := r.Slowdown(baseline); ok {
+			t.Errorf("%s: Slowdown should not compare against a missing or zero baseline", r.Key())
+		}
+	}
+}
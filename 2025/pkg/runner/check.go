@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"aoc2025/pkg/parser"
+)
+
+// ReadExampleAnswers reads the expected answers stored next to a day's
+// example input, in inputs/dayN_example.answers
+func ReadExampleAnswers(day int) (Answers, error) {
+	return ReadAnswers(parser.InputDir + "/day" + strconv.Itoa(day) + "_example.answers")
+}
+
+// CheckExamples solves the example input for every part listed in the day's
+// example answers and compares the results.
+// It returns an error wrapping fs.ErrNotExist when the day has no answers file.
+func CheckExamples(day Day) error {
+	want, err := ReadExampleAnswers(day.Number)
+	if err != nil {
+		return err
+	}
+
+	got := Answers{}
+	var errs []error
+	for _, part := range want.Parts() {
+		// Read the input for every part, some solvers modify it in place
+		input, err := parser.ReadExample(day.Number)
+		if err != nil {
+			return err
+		}
+
+		answer, err := day.Solve(part, input)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("part %d: %w", part, err))
+			continue
+		}
+		got[part] = answer
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if diff := want.Diff(got); diff != "" {
+		return fmt.Errorf("example answers differ (-want +got):\n%s", diff)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package runner
+
+import (
+	"fmt"
+
+	"aoc2025/pkg/parser"
+)
+
+// Preparer is implemented by solutions with a separate parse phase.
+// Prepare parses the input once into a model both parts are solved from.
+type Preparer interface {
+	Prepare(input *parser.Input) (Prepared, error)
+}
+
+// Prepared is a parsed input ready to be solved
+type Prepared interface {
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// Parsed builds a Solution whose parse phase turns the input into a typed
+// model M shared by both parts. The parts must not modify the model.
+func Parsed[M any](parse func(input *parser.Input) (M, error), part1, part2 func(model M) (Answer, error)) Solution {
+	return parsedSolution[M]{parse: parse, part1: part1, part2: part2}
+}
+
+type parsedSolution[M any] struct {
+	parse        func(input *parser.Input) (M, error)
+	part1, part2 func(model M) (Answer, error)
+}
+
+func (s parsedSolution[M]) Prepare(input *parser.Input) (Prepared, error) {
+	model, err := s.parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return parsedModel[M]{solution: s, model: model}, nil
+}
+
+func (s parsedSolution[M]) Part1(input *parser.Input) (Answer, error) {
+	prepared, err := s.Prepare(input)
+	if err != nil {
+		return Answer{}, err
+	}
+	return prepared.Part1()
+}
+
+func (s parsedSolution[M]) Part2(input *parser.Input) (Answer, error) {
+	prepared, err := s.Prepare(input)
+	if err != nil {
+		return Answer{}, err
+	}
+	return prepared.Part2()
+}
+
+type parsedModel[M any] struct {
+	solution parsedSolution[M]
+	model    M
+}
+
+func (p parsedModel[M]) Part1() (Answer, error) {
+	return p.solution.part1(p.model)
+}
+
+func (p parsedModel[M]) Part2() (Answer, error) {
+	return p.solution.part2(p.model)
+}
+
+// unparsed adapts a solution without a parse phase to Prepared
+type unparsed struct {
+	solution Solution
+	input    *parser.Input
+}
+
+func (u unparsed) Part1() (Answer, error) {
+	return u.solution.Part1(u.input)
+}
+
+func (u unparsed) Part2() (Answer, error) {
+	return u.solution.Part2(u.input)
+}
+
+// SolvePrepared runs part 1 or 2 of a prepared input
+func SolvePrepared(prepared Prepared, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return prepared.Part1()
+	case 2:
+		return prepared.Part2()
+	}
+	return Answer{}, fmt.Errorf("no part %d", part)
+}
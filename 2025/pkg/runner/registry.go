@@ -0,0 +1,98 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"aoc2025/pkg/parser"
+)
+
+// ErrNotImplemented is returned by parts that have not been solved yet
+var ErrNotImplemented = errors.New("not implemented")
+
+// Solution solves both parts of a day's puzzle
+type Solution interface {
+	Part1(input *parser.Input) (Answer, error)
+	Part2(input *parser.Input) (Answer, error)
+}
+
+// Solver solves one part of a day's puzzle
+type Solver func(input *parser.Input) (Answer, error)
+
+// Funcs adapts a pair of solver functions to the Solution interface
+func Funcs(part1, part2 Solver) Solution {
+	return solverFuncs{part1: part1, part2: part2}
+}
+
+type solverFuncs struct {
+	part1, part2 Solver
+}
+
+func (s solverFuncs) Part1(input *parser.Input) (Answer, error) {
+	return s.part1(input)
+}
+
+func (s solverFuncs) Part2(input *parser.Input) (Answer, error) {
+	return s.part2(input)
+}
+
+// Day is a registered solution for a single day
+type Day struct {
+	Number   int
+	Solution Solution
+}
+
+// Solve runs part 1 or 2 of the day's solution
+func (d Day) Solve(part int, input *parser.Input) (Answer, error) {
+	switch part {
+	case 1:
+		return d.Solution.Part1(input)
+	case 2:
+		return d.Solution.Part2(input)
+	}
+	return Answer{}, fmt.Errorf("day %d has no part %d", d.Number, part)
+}
+
+// HasParsePhase reports whether the day's solution parses its input separately
+func (d Day) HasParsePhase() bool {
+	_, ok := d.Solution.(Preparer)
+	return ok
+}
+
+// Prepare runs the parse phase of the day's solution, if it has one.
+// Solutions without a parse phase are handed the raw input by each part.
+func (d Day) Prepare(input *parser.Input) (Prepared, error) {
+	if preparer, ok := d.Solution.(Preparer); ok {
+		return preparer.Prepare(input)
+	}
+	return unparsed{solution: d.Solution, input: input}, nil
+}
+
+var registry = make(map[int]Day)
+
+// Register adds a day's solution to the registry.
+// It is meant to be called from each day's init function and panics if
+// the same day is registered twice.
+func Register(day int, solution Solution) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("day %d registered twice", day))
+	}
+	registry[day] = Day{Number: day, Solution: solution}
+}
+
+// Get returns the solution registered for a day
+func Get(day int) (Day, bool) {
+	d, ok := registry[day]
+	return d, ok
+}
+
+// Days returns all registered day numbers in ascending order
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
@@ -0,0 +1,73 @@
+package search
+
+import "aoc2025/pkg/utils"
+
+// Goal returns an isGoal function accepting only target
+func Goal[N comparable](target N) func(N) bool {
+	return func(n N) bool {
+		return n == target
+	}
+}
+
+// Unweighted turns a BFS neighbor function into edges of cost 1,
+// for use with Dijkstra or AStar
+func Unweighted[N comparable](neighbors func(N) []N) func(N) []Edge[N] {
+	return func(n N) []Edge[N] {
+		next := neighbors(n)
+		edges := make([]Edge[N], len(next))
+		for i, to := range next {
+			edges[i] = Edge[N]{To: to, Cost: 1}
+		}
+		return edges
+	}
+}
+
+// Adjacency searches an adjacency map; nodes missing from it have no neighbors
+func Adjacency[N comparable](adj map[N][]N) func(N) []N {
+	return func(n N) []N {
+		return adj[n]
+	}
+}
+
+// WeightedAdjacency searches a weighted adjacency map
+func WeightedAdjacency[N comparable](adj map[N][]Edge[N]) func(N) []Edge[N] {
+	return func(n N) []Edge[N] {
+		return adj[n]
+	}
+}
+
+// GridNeighbors moves between in-bounds grid cells in the given directions
+// (such as utils.Cardinals) whose value passable accepts
+func GridNeighbors[T any](g *utils.Grid[T], dirs []utils.Point2D, passable func(T) bool) func(utils.Point2D) []utils.Point2D {
+	return func(p utils.Point2D) []utils.Point2D {
+		var next []utils.Point2D
+		for q, value := range g.Neighbors(p, dirs) {
+			if passable(value) {
+				next = append(next, q)
+			}
+		}
+		return next
+	}
+}
+
+// GridEdges moves between in-bounds grid cells in the given directions.
+// cost returns the price of stepping from one cell value to the next, and
+// false when the step is not allowed.
+func GridEdges[T any](g *utils.Grid[T], dirs []utils.Point2D, cost func(from, to T) (int, bool)) func(utils.Point2D) []Edge[utils.Point2D] {
+	return func(p utils.Point2D) []Edge[utils.Point2D] {
+		from := g.At(p)
+		var edges []Edge[utils.Point2D]
+		for q, to := range g.Neighbors(p, dirs) {
+			if c, ok := cost(from, to); ok {
+				edges = append(edges, Edge[utils.Point2D]{To: q, Cost: c})
+			}
+		}
+		return edges
+	}
+}
+
+// Manhattan returns a heuristic estimating the distance to target with
+// Point2D.Manhattan, admissible for grids moving in Cardinals with cost >= 1
+func Manhattan(target utils.Point2D) func(utils.Point2D) int {
+	return target.Manhattan
+}
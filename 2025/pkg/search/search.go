@@ -0,0 +1,152 @@
+// Package search finds shortest paths over any comparable node type.
+//
+// Neighbors are given as functions so the same searches work on grids,
+// adjacency maps, or graphs generated on the fly. See adapters.go for
+// ready-made neighbor functions.
+package search
+
+import "container/heap"
+
+// Edge is a weighted link to a neighboring node
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Result holds the outcome of a search
+type Result[N comparable] struct {
+	Found   bool      // whether a goal node was reached
+	Path    []N       // start to goal, both included; nil when not found
+	Cost    int       // total cost of Path
+	Visited map[N]int // settled nodes with their cost from start; for BFS also the discovered frontier
+}
+
+// BFS finds the path with the fewest steps from start to the first node
+// accepted by isGoal. A nil isGoal explores everything reachable.
+func BFS[N comparable](start N, neighbors func(N) []N, isGoal func(N) bool) Result[N] {
+	visited := map[N]int{start: 0}
+	parent := map[N]N{}
+	queue := []N{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if isGoal != nil && isGoal(current) {
+			return Result[N]{
+				Found:   true,
+				Path:    buildPath(parent, start, current),
+				Cost:    visited[current],
+				Visited: visited,
+			}
+		}
+
+		for _, next := range neighbors(current) {
+			if _, seen := visited[next]; seen {
+				continue
+			}
+			visited[next] = visited[current] + 1
+			parent[next] = current
+			queue = append(queue, next)
+		}
+	}
+
+	return Result[N]{Visited: visited}
+}
+
+// Dijkstra finds the cheapest path from start to the first node accepted by
+// isGoal. Edge costs must not be negative. A nil isGoal settles every
+// reachable node, which makes Result.Visited a map of shortest distances.
+func Dijkstra[N comparable](start N, neighbors func(N) []Edge[N], isGoal func(N) bool) Result[N] {
+	return AStar(start, neighbors, isGoal, nil)
+}
+
+// AStar is Dijkstra guided by a heuristic estimating the remaining cost to a
+// goal. Settled nodes are never reopened, so the heuristic must be
+// consistent: h(n) <= cost(n, m) + h(m) for every edge n -> m, and zero at
+// goals. Otherwise the path found may not be the cheapest. Manhattan on unit
+// grid steps qualifies. A nil heuristic behaves like Dijkstra.
+func AStar[N comparable](start N, neighbors func(N) []Edge[N], isGoal func(N) bool, heuristic func(N) int) Result[N] {
+	estimate := func(n N) int {
+		if heuristic == nil {
+			return 0
+		}
+		return heuristic(n)
+	}
+
+	best := map[N]int{start: 0}
+	settled := map[N]int{}
+	parent := map[N]N{}
+	open := &priorityQueue[N]{}
+	heap.Push(open, queued[N]{node: start, cost: 0, priority: estimate(start)})
+
+	for open.Len() > 0 {
+		item := heap.Pop(open).(queued[N])
+		if _, done := settled[item.node]; done {
+			continue // stale entry, a cheaper one was already settled
+		}
+		settled[item.node] = item.cost
+
+		if isGoal != nil && isGoal(item.node) {
+			return Result[N]{
+				Found:   true,
+				Path:    buildPath(parent, start, item.node),
+				Cost:    item.cost,
+				Visited: settled,
+			}
+		}
+
+		for _, edge := range neighbors(item.node) {
+			if _, done := settled[edge.To]; done {
+				continue
+			}
+			cost := item.cost + edge.Cost
+			if known, ok := best[edge.To]; ok && known <= cost {
+				continue
+			}
+			best[edge.To] = cost
+			parent[edge.To] = item.node
+			heap.Push(open, queued[N]{node: edge.To, cost: cost, priority: cost + estimate(edge.To)})
+		}
+	}
+
+	return Result[N]{Visited: settled}
+}
+
+// buildPath walks the parent links back from goal to start
+func buildPath[N comparable](parent map[N]N, start, goal N) []N {
+	path := []N{goal}
+	for current := goal; current != start; {
+		current = parent[current]
+		path = append(path, current)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// queued is a node waiting in the open set
+type queued[N comparable] struct {
+	node     N
+	cost     int // cost from start
+	priority int // cost plus heuristic
+}
+
+// priorityQueue is a binary min-heap of queued nodes, used through container/heap
+type priorityQueue[N comparable] []queued[N]
+
+func (q priorityQueue[N]) Len() int           { return len(q) }
+func (q priorityQueue[N]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q priorityQueue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue[N]) Push(x any) {
+	*q = append(*q, x.(queued[N]))
+}
+
+func (q *priorityQueue[N]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,135 @@
+package search
+
+import (
+	"testing"
+
+	"aoc2025/pkg/utils"
+)
+
+// maze builds a rune grid from rows and finds its S and E cells
+func maze(t *testing.T, rows ...string) (*utils.Grid[rune], utils.Point2D, utils.Point2D) {
+	t.Helper()
+	cells := make([][]rune, len(rows))
+	for i, row := range rows {
+		cells[i] = []rune(row)
+	}
+	g := utils.GridFromRows(cells)
+	start, ok1 := utils.Find(g, 'S')
+	end, ok2 := utils.Find(g, 'E')
+	if !ok1 || !ok2 {
+		t.Fatal("maze needs an S and an E")
+	}
+	return g, start, end
+}
+
+func open(r rune) bool {
+	return r != '#'
+}
+
+// checkPath verifies that path is a walk of single open steps from start to end
+func checkPath(t *testing.T, name string, g *utils.Grid[rune], path []utils.Point2D, start, end utils.Point2D) {
+	t.Helper()
+	if len(path) == 0 || path[0] != start || path[len(path)-1] != end {
+		t.Errorf("%s: path %v does not run from %v to %v", name, path, start, end)
+		return
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i-1].Manhattan(path[i]) != 1 || !open(g.At(path[i])) {
+			t.Errorf("%s: invalid step from %v to %v", name, path[i-1], path[i])
+		}
+	}
+}
+
+func TestSearchesAgreeOnMaze(t *testing.T) {
+	g, start, end := maze(t,
+		"S..#....",
+		".#.#.##.",
+		".#...#..",
+		".####.#.",
+		"......#E",
+	)
+	neighbors := GridNeighbors(g, utils.Cardinals, open)
+
+	results := map[string]Result[utils.Point2D]{
+		"BFS":      BFS(start, neighbors, Goal(end)),
+		"Dijkstra": Dijkstra(start, Unweighted(neighbors), Goal(end)),
+		"AStar":    AStar(start, Unweighted(neighbors), Goal(end), Manhattan(end)),
+	}
+
+	const want = 15
+	for name, result := range results {
+		if !result.Found {
+			t.Errorf("%s: goal not found", name)
+			continue
+		}
+		if result.Cost != want {
+			t.Errorf("%s: cost = %d, want %d", name, result.Cost, want)
+		}
+		if len(result.Path) != result.Cost+1 {
+			t.Errorf("%s: path has %d nodes for cost %d", name, len(result.Path), result.Cost)
+		}
+		checkPath(t, name, g, result.Path, start, end)
+	}
+}
+
+func TestWeightedSearchesAgree(t *testing.T) {
+	// Entering a cell costs its digit; the straight row is short but dear
+	rows := [][]int{
+		{0, 9, 9, 9, 1},
+		{1, 1, 1, 1, 1},
+	}
+	g := utils.GridFromRows(rows)
+	start, end := utils.Point2D{X: 0, Y: 0}, utils.Point2D{X: 4, Y: 0}
+	edges := GridEdges(g, utils.Cardinals, func(_, to int) (int, bool) {
+		return to, true
+	})
+
+	dijkstra := Dijkstra(start, edges, Goal(end))
+	astar := AStar(start, edges, Goal(end), Manhattan(end))
+	bfs := BFS(start, GridNeighbors(g, utils.Cardinals, func(int) bool { return true }), Goal(end))
+
+	if !dijkstra.Found || dijkstra.Cost != 6 {
+		t.Errorf("Dijkstra: found %v with cost %d, want cost 6", dijkstra.Found, dijkstra.Cost)
+	}
+	if !astar.Found || astar.Cost != dijkstra.Cost {
+		t.Errorf("AStar: found %v with cost %d, want %d", astar.Found, astar.Cost, dijkstra.Cost)
+	}
+	if bfs.Cost != 4 {
+		t.Errorf("BFS: %d steps, want 4", bfs.Cost)
+	}
+	for _, path := range [][]utils.Point2D{dijkstra.Path, astar.Path} {
+		if path[0] != start || path[len(path)-1] != end {
+			t.Errorf("path %v does not run from %v to %v", path, start, end)
+		}
+	}
+}
+
+func TestUnreachableGoal(t *testing.T) {
+	g, start, end := maze(t,
+		"S.#..",
+		"..#.E",
+	)
+	neighbors := GridNeighbors(g, utils.Cardinals, open)
+
+	results := map[string]Result[utils.Point2D]{
+		"BFS":      BFS(start, neighbors, Goal(end)),
+		"Dijkstra": Dijkstra(start, Unweighted(neighbors), Goal(end)),
+		"AStar":    AStar(start, Unweighted(neighbors), Goal(end), Manhattan(end)),
+	}
+	for name, result := range results {
+		if result.Found || result.Path != nil {
+			t.Errorf("%s: found path %v to an unreachable goal", name, result.Path)
+		}
+		if got := len(result.Visited); got != 4 {
+			t.Errorf("%s: visited %d cells, want the 4 reachable ones", name, got)
+		}
+	}
+}
+
+func TestStartIsGoal(t *testing.T) {
+	start := utils.Point2D{X: 1, Y: 1}
+	result := BFS(start, func(utils.Point2D) []utils.Point2D { return nil }, Goal(start))
+	if !result.Found || result.Cost != 0 || len(result.Path) != 1 || result.Path[0] != start {
+		t.Errorf("got %+v, want a one-node path of cost 0", result)
+	}
+}
@@ -0,0 +1,209 @@
+// Package spatial indexes 3D points for nearest-neighbor and closest-pair
+// queries. Distances are squared Euclidean distances on integers, so they
+// are exact and never need a square root.
+package spatial
+
+import (
+	"container/heap"
+	"iter"
+	"sort"
+)
+
+// Point3 is an integer point in 3D space
+type Point3 struct {
+	X, Y, Z int
+}
+
+// DistSq returns the squared straight-line distance between p and q
+func (p Point3) DistSq(q Point3) int {
+	dx, dy, dz := p.X-q.X, p.Y-q.Y, p.Z-q.Z
+	return dx*dx + dy*dy + dz*dz
+}
+
+func (p Point3) coord(axis int) int {
+	switch axis {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	}
+	return p.Z
+}
+
+// KDTree is a static k-d tree over a set of points.
+// Queries refer to points by their index in the slice given to NewKDTree.
+type KDTree struct {
+	points []Point3
+	nodes  []kdNode
+	root   int
+}
+
+// kdNode holds one point and splits the rest of its subtree on axis
+type kdNode struct {
+	point       int // index into points
+	left, right int // child nodes, -1 when missing
+	lo, hi      Point3
+}
+
+// NewKDTree builds a tree over points. The slice is not modified.
+func NewKDTree(points []Point3) *KDTree {
+	t := &KDTree{points: points, nodes: make([]kdNode, 0, len(points))}
+	indices := make([]int, len(points))
+	for i := range indices {
+		indices[i] = i
+	}
+	t.root = t.build(indices, 0)
+	return t
+}
+
+// build creates the subtree over indices, splitting on the median along
+// axis, and returns its node or -1 if indices is empty
+func (t *KDTree) build(indices []int, axis int) int {
+	if len(indices) == 0 {
+		return -1
+	}
+
+	sort.Slice(indices, func(a, b int) bool {
+		return t.points[indices[a]].coord(axis) < t.points[indices[b]].coord(axis)
+	})
+	mid := len(indices) / 2
+
+	// The bounding box of the subtree gives a lower bound on the distance
+	// from a query to anything in it
+	lo, hi := t.points[indices[0]], t.points[indices[0]]
+	for _, i := range indices[1:] {
+		p := t.points[i]
+		lo = Point3{X: min(lo.X, p.X), Y: min(lo.Y, p.Y), Z: min(lo.Z, p.Z)}
+		hi = Point3{X: max(hi.X, p.X), Y: max(hi.Y, p.Y), Z: max(hi.Z, p.Z)}
+	}
+
+	node := len(t.nodes)
+	t.nodes = append(t.nodes, kdNode{point: indices[mid], lo: lo, hi: hi})
+	next := (axis + 1) % 3
+	left := t.build(indices[:mid], next)
+	right := t.build(indices[mid+1:], next)
+	t.nodes[node].left, t.nodes[node].right = left, right
+	return node
+}
+
+// Len returns the number of points in the tree
+func (t *KDTree) Len() int {
+	return len(t.points)
+}
+
+// Point returns the point with index i
+func (t *KDTree) Point(i int) Point3 {
+	return t.points[i]
+}
+
+// Neighbors iterates over the indices of all points by increasing distance
+// from q, with their squared distances. Points at the same distance come in
+// index order. Stopping early skips the rest of the search.
+func (t *KDTree) Neighbors(q Point3) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		c := t.cursor(q, -1)
+		for {
+			i, d, ok := c.next()
+			if !ok || !yield(i, d) {
+				return
+			}
+		}
+	}
+}
+
+// Nearest returns the index of the point closest to q and its squared
+// distance. ok is false if the tree is empty.
+func (t *KDTree) Nearest(q Point3) (index, distSq int, ok bool) {
+	return t.cursor(q, -1).next()
+}
+
+// cursor starts a best-first search from q that only reports points with
+// an index above after
+func (t *KDTree) cursor(q Point3, after int) *cursor {
+	c := &cursor{tree: t, query: q, after: after}
+	if t.root != -1 {
+		c.push(t.root)
+	}
+	return c
+}
+
+// cursor walks the tree best first: a heap holds subtrees keyed by the
+// distance to their bounding box, and points keyed by their exact distance.
+// A point only comes out once no subtree can hold anything closer.
+type cursor struct {
+	tree  *KDTree
+	query Point3
+	after int
+	queue entryQueue
+}
+
+func (c *cursor) push(node int) {
+	n := c.tree.nodes[node]
+	heap.Push(&c.queue, entry{dist: boxDistSq(c.query, n.lo, n.hi), node: node})
+}
+
+// next returns the next closest point
+func (c *cursor) next() (index, distSq int, ok bool) {
+	for c.queue.Len() > 0 {
+		e := heap.Pop(&c.queue).(entry)
+		if e.isPoint {
+			return e.point, e.dist, true
+		}
+
+		n := c.tree.nodes[e.node]
+		if n.point > c.after {
+			heap.Push(&c.queue, entry{dist: c.query.DistSq(c.tree.points[n.point]), isPoint: true, point: n.point})
+		}
+		if n.left != -1 {
+			c.push(n.left)
+		}
+		if n.right != -1 {
+			c.push(n.right)
+		}
+	}
+	return 0, 0, false
+}
+
+// boxDistSq returns the squared distance from q to the nearest point of the
+// box from lo to hi
+func boxDistSq(q, lo, hi Point3) int {
+	clamp := func(v, lo, hi int) int {
+		return max(lo, min(v, hi))
+	}
+	return q.DistSq(Point3{X: clamp(q.X, lo.X, hi.X), Y: clamp(q.Y, lo.Y, hi.Y), Z: clamp(q.Z, lo.Z, hi.Z)})
+}
+
+// entry is a subtree or a point waiting in a cursor
+type entry struct {
+	dist    int
+	isPoint bool
+	point   int // when isPoint
+	node    int // otherwise
+}
+
+// less orders by distance, then subtrees before points so that a point at
+// distance d only comes out after every subtree that could hold another
+// point at d was opened, then points by index
+func (e entry) less(other entry) bool {
+	if e.dist != other.dist {
+		return e.dist < other.dist
+	}
+	if e.isPoint != other.isPoint {
+		return !e.isPoint
+	}
+	return e.point < other.point
+}
+
+// entryQueue is a binary min-heap of entries, used through container/heap
+type entryQueue []entry
+
+func (q entryQueue) Len() int           { return len(q) }
+func (q entryQueue) Less(i, j int) bool { return q[i].less(q[j]) }
+func (q entryQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *entryQueue) Push(x any)        { *q = append(*q, x.(entry)) }
+func (q *entryQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
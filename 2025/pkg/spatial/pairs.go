@@ -0,0 +1,68 @@
+package spatial
+
+import (
+	"container/heap"
+	"iter"
+)
+
+// Pair is two point indices, I < J, and their squared distance
+type Pair struct {
+	I, J   int
+	DistSq int
+}
+
+// Pairs iterates over every pair of points by increasing distance, ties
+// broken by I and then J. Pairs are generated lazily, so stopping after the
+// first k costs far less than sorting all n² pairs.
+//
+// Each point i has a best-first cursor over the points j > i, and a heap
+// holds the next pair of every cursor; taking a pair advances only its own
+// cursor.
+func (t *KDTree) Pairs() iter.Seq[Pair] {
+	return func(yield func(Pair) bool) {
+		cursors := make([]*cursor, len(t.points))
+		var queue pairQueue
+		advance := func(i int) {
+			if j, d, ok := cursors[i].next(); ok {
+				heap.Push(&queue, Pair{I: i, J: j, DistSq: d})
+			}
+		}
+
+		for i, p := range t.points {
+			cursors[i] = t.cursor(p, i)
+			advance(i)
+		}
+
+		for queue.Len() > 0 {
+			pair := heap.Pop(&queue).(Pair)
+			if !yield(pair) {
+				return
+			}
+			advance(pair.I)
+		}
+	}
+}
+
+func (p Pair) less(other Pair) bool {
+	if p.DistSq != other.DistSq {
+		return p.DistSq < other.DistSq
+	}
+	if p.I != other.I {
+		return p.I < other.I
+	}
+	return p.J < other.J
+}
+
+// pairQueue is a binary min-heap of pairs, used through container/heap
+type pairQueue []Pair
+
+func (q pairQueue) Len() int           { return len(q) }
+func (q pairQueue) Less(i, j int) bool { return q[i].less(q[j]) }
+func (q pairQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *pairQueue) Push(x any)        { *q = append(*q, x.(Pair)) }
+func (q *pairQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,108 @@
+package spatial
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomPoints returns n points in a small cube, so that duplicate points
+// and equal distances are common
+func randomPoints(rng *rand.Rand, n, size int) []Point3 {
+	points := make([]Point3, n)
+	for i := range points {
+		points[i] = Point3{X: rng.Intn(size), Y: rng.Intn(size), Z: rng.Intn(size)}
+	}
+	return points
+}
+
+// testSets covers the empty tree, a single point, all points equal and
+// random clouds with many ties
+func testSets() map[string][]Point3 {
+	rng := rand.New(rand.NewSource(1))
+	return map[string][]Point3{
+		"empty":      nil,
+		"single":     {{X: 1, Y: 2, Z: 3}},
+		"duplicates": {{X: 1, Y: 1, Z: 1}, {X: 1, Y: 1, Z: 1}, {X: 0, Y: 0, Z: 0}, {X: 1, Y: 1, Z: 1}},
+		"line":       {{X: 0}, {X: 2}, {X: 1}, {X: 3}, {X: 5}, {X: 4}},
+		"small":      randomPoints(rng, 30, 4),
+		"large":      randomPoints(rng, 200, 20),
+	}
+}
+
+func TestPairsMatchesBruteForce(t *testing.T) {
+	for name, points := range testSets() {
+		t.Run(name, func(t *testing.T) {
+			var want []Pair
+			for i := range points {
+				for j := i + 1; j < len(points); j++ {
+					want = append(want, Pair{I: i, J: j, DistSq: points[i].DistSq(points[j])})
+				}
+			}
+			slices.SortFunc(want, func(a, b Pair) int {
+				return cmp.Or(cmp.Compare(a.DistSq, b.DistSq), cmp.Compare(a.I, b.I), cmp.Compare(a.J, b.J))
+			})
+
+			got := slices.Collect(NewKDTree(points).Pairs())
+			if !slices.Equal(got, want) {
+				t.Errorf("Pairs() differs from brute force:\ngot  %v\nwant %v", head(got), head(want))
+			}
+		})
+	}
+}
+
+func TestPairsStopsEarly(t *testing.T) {
+	points := testSets()["large"]
+	var got []Pair
+	for pair := range NewKDTree(points).Pairs() {
+		got = append(got, pair)
+		if len(got) == 5 {
+			break
+		}
+	}
+	all := slices.Collect(NewKDTree(points).Pairs())
+	if !slices.Equal(got, all[:5]) {
+		t.Errorf("first five pairs = %v, want %v", got, all[:5])
+	}
+}
+
+func TestNeighborsMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for name, points := range testSets() {
+		t.Run(name, func(t *testing.T) {
+			tree := NewKDTree(points)
+			queries := append(randomPoints(rng, 10, 20), points...)
+			for _, q := range queries {
+				want := make([]int, len(points))
+				for i := range want {
+					want[i] = i
+				}
+				slices.SortStableFunc(want, func(a, b int) int {
+					return cmp.Compare(q.DistSq(points[a]), q.DistSq(points[b]))
+				})
+
+				var got []int
+				for i, d := range tree.Neighbors(q) {
+					if d != q.DistSq(points[i]) {
+						t.Fatalf("Neighbors(%v) gave point %d at distance %d, want %d", q, i, d, q.DistSq(points[i]))
+					}
+					got = append(got, i)
+				}
+				if !slices.Equal(got, want) {
+					t.Fatalf("Neighbors(%v) = %v, want %v", q, head(got), head(want))
+				}
+
+				index, d, ok := tree.Nearest(q)
+				if ok != (len(points) > 0) || (ok && (index != want[0] || d != q.DistSq(points[want[0]]))) {
+					t.Fatalf("Nearest(%v) = %d, %d, %v, want %v", q, index, d, ok, head(want))
+				}
+			}
+		})
+	}
+}
+
+// head returns the start of s, to keep failure messages short
+func head[T any](s []T) []T {
+	return s[:min(len(s), 10)]
+}
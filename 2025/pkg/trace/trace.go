@@ -0,0 +1,238 @@
+// Package trace lets solvers describe what they do as nested spans and
+// key/value events, without deciding how or whether it is shown.
+//
+// Tracing is off until Enable is called, usually by cmd/aoc's -trace flag,
+// and then renders either as an indented tree for reading or as JSON lines
+// for tools. While off, every call returns immediately, so solvers can
+// trace unconditionally; check Enabled before building expensive values.
+//
+//	span := trace.Start("splitter", "x", x, "y", y)
+//	trace.Event("bottom reached", "x", x)
+//	span.End("timelines", total)
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Format selects how trace records are rendered
+type Format int
+
+const (
+	Off Format = iota
+	Tree
+	JSON
+)
+
+func (f Format) String() string {
+	switch f {
+	case Off:
+		return "off"
+	case Tree:
+		return "tree"
+	case JSON:
+		return "json"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// ParseFormat parses "off", "tree" or "json"
+func ParseFormat(s string) (Format, error) {
+	for _, f := range []Format{Off, Tree, JSON} {
+		if s == f.String() {
+			return f, nil
+		}
+	}
+	return Off, fmt.Errorf("unknown trace format %q (want off, tree or json)", s)
+}
+
+var tracer struct {
+	mu     sync.Mutex
+	format Format
+	w      io.Writer
+	depth  int
+	start  time.Time
+}
+
+// Enable sends all further trace records to w in the given format.
+// Enable(nil, Off) turns tracing off again.
+func Enable(w io.Writer, format Format) {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	if w == nil {
+		format = Off
+	}
+	tracer.format = format
+	tracer.w = w
+	tracer.depth = 0
+	tracer.start = time.Now()
+}
+
+// Enabled reports whether trace records are being written
+func Enabled() bool {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	return tracer.format != Off
+}
+
+// Span is an open section of work. Records made before End are nested
+// under it. The zero Span, returned while tracing is off, does nothing.
+type Span struct {
+	name  string
+	start time.Time
+}
+
+// Start opens a span with alternating keys and values
+func Start(name string, kv ...any) Span {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	if tracer.format == Off {
+		return Span{}
+	}
+	write("start", name, 0, kv)
+	tracer.depth++
+	return Span{name: name, start: time.Now()}
+}
+
+// End closes the span. Keys and values given here, typically results, are
+// reported with it; in the tree format a span without them ends silently.
+func (s Span) End(kv ...any) {
+	if s.start.IsZero() {
+		return
+	}
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	if tracer.format == Off {
+		return
+	}
+	tracer.depth = max(tracer.depth-1, 0)
+	write("end", s.name, time.Since(s.start), kv)
+}
+
+// Event records something that happened, with alternating keys and values
+func Event(name string, kv ...any) {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	if tracer.format == Off {
+		return
+	}
+	write("event", name, 0, kv)
+}
+
+// write renders one record; the caller holds the lock
+func write(kind, name string, duration time.Duration, kv []any) {
+	switch tracer.format {
+	case Tree:
+		writeTree(kind, name, duration, kv)
+	case JSON:
+		writeJSON(kind, name, duration, kv)
+	}
+}
+
+func writeTree(kind, name string, duration time.Duration, kv []any) {
+	var marker string
+	switch kind {
+	case "start":
+		marker = "▸"
+	case "event":
+		marker = "·"
+	case "end":
+		if len(kv) == 0 {
+			return
+		}
+		marker = "◂"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.Repeat("  ", tracer.depth))
+	sb.WriteString(marker)
+	sb.WriteByte(' ')
+	sb.WriteString(name)
+	for _, f := range pairs(kv) {
+		value := fmt.Sprint(f.value)
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = fmt.Sprintf("%q", value)
+		}
+		fmt.Fprintf(&sb, " %s=%s", f.key, value)
+	}
+	if kind == "end" {
+		fmt.Fprintf(&sb, " (%v)", duration.Round(time.Microsecond))
+	}
+	sb.WriteByte('\n')
+	io.WriteString(tracer.w, sb.String())
+}
+
+// record is one JSON line
+type record struct {
+	Kind       string         `json:"kind"`
+	Name       string         `json:"name"`
+	Depth      int            `json:"depth"`
+	ElapsedUS  int64          `json:"elapsed_us"`
+	DurationUS *int64         `json:"duration_us,omitempty"`
+	Fields     map[string]any `json:"fields,omitempty"`
+}
+
+func writeJSON(kind, name string, duration time.Duration, kv []any) {
+	r := record{
+		Kind:      kind,
+		Name:      name,
+		Depth:     tracer.depth,
+		ElapsedUS: time.Since(tracer.start).Microseconds(),
+	}
+	if kind == "end" {
+		us := duration.Microseconds()
+		r.DurationUS = &us
+	}
+	for _, f := range pairs(kv) {
+		if r.Fields == nil {
+			r.Fields = make(map[string]any)
+		}
+		r.Fields[f.key] = jsonValue(f.value)
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		data = []byte(fmt.Sprintf(`{"kind":"error","name":%q}`, err.Error()))
+	}
+	tracer.w.Write(append(data, '\n'))
+}
+
+// jsonValue keeps values JSON understands and formats everything else
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case nil, bool, string,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	case []int, []string:
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+type field struct {
+	key   string
+	value any
+}
+
+// pairs splits alternating keys and values. A missing final value is
+// reported under the key "!BADKEY", as log/slog does.
+func pairs(kv []any) []field {
+	fields := make([]field, 0, (len(kv)+1)/2)
+	for i := 0; i < len(kv); i += 2 {
+		if i+1 == len(kv) {
+			fields = append(fields, field{key: "!BADKEY", value: kv[i]})
+			break
+		}
+		fields = append(fields, field{key: fmt.Sprint(kv[i]), value: kv[i+1]})
+	}
+	return fields
+}
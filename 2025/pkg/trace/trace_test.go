@@ -0,0 +1,131 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// sample traces a small nested run in the given format and returns the output
+func sample(t *testing.T, format Format) string {
+	t.Helper()
+	var buf bytes.Buffer
+	Enable(&buf, format)
+	t.Cleanup(func() { Enable(nil, Off) })
+
+	outer := Start("solve", "part", 1)
+	Event("parsed", "lines", 3)
+	inner := Start("problem", "rows", "1 2|*")
+	Event("step", "left", 1, "right", 2)
+	inner.End("result", 2, "numbers", []int{1, 2})
+	silent := Start("quiet")
+	silent.End()
+	outer.End("answer", 2)
+	Event("done", "odd")
+	return buf.String()
+}
+
+// durations matches the timing that ends every closing tree line
+var durations = regexp.MustCompile(` \([^)]*s\)$`)
+
+func TestTree(t *testing.T) {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(sample(t, Tree), "\n"), "\n") {
+		lines = append(lines, durations.ReplaceAllString(line, ""))
+	}
+	want := []string{
+		"▸ solve part=1",
+		"  · parsed lines=3",
+		`  ▸ problem rows="1 2|*"`,
+		"    · step left=1 right=2",
+		`  ◂ problem result=2 numbers="[1 2]"`,
+		"  ▸ quiet",
+		"◂ solve answer=2",
+		"· done !BADKEY=odd",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSuffix(sample(t, JSON), "\n"), "\n") {
+		var r map[string]any
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("line %q is not JSON: %v", line, err)
+		}
+		records = append(records, r)
+	}
+
+	want := []struct {
+		kind, name string
+		depth      float64
+	}{
+		{"start", "solve", 0},
+		{"event", "parsed", 1},
+		{"start", "problem", 1},
+		{"event", "step", 2},
+		{"end", "problem", 1},
+		{"start", "quiet", 1},
+		{"end", "quiet", 1}, // unlike the tree, JSON reports every end
+		{"end", "solve", 0},
+		{"event", "done", 0},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d", len(records), len(want))
+	}
+	for i, w := range want {
+		r := records[i]
+		if r["kind"] != w.kind || r["name"] != w.name || r["depth"] != w.depth {
+			t.Errorf("record %d = %v, want %s %s at depth %v", i, r, w.kind, w.name, w.depth)
+		}
+		if _, ok := r["duration_us"]; ok != (w.kind == "end") {
+			t.Errorf("record %d: duration_us present %v, want %v", i, ok, w.kind == "end")
+		}
+	}
+
+	fields := records[4]["fields"].(map[string]any)
+	if fields["result"] != 2.0 || len(fields["numbers"].([]any)) != 2 {
+		t.Errorf("end fields = %v, want result 2 and two numbers", fields)
+	}
+	if _, ok := records[5]["fields"]; ok {
+		t.Errorf("a span without fields should omit them, got %v", records[5])
+	}
+}
+
+func TestOff(t *testing.T) {
+	var buf bytes.Buffer
+	Enable(&buf, Off)
+	if Enabled() {
+		t.Fatal("Enabled() after Enable(w, Off)")
+	}
+	span := Start("ignored")
+	Event("ignored")
+	span.End("x", 1)
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q while off", buf.String())
+	}
+
+	// Ending a span after tracing was turned off is harmless
+	Enable(&buf, Tree)
+	span = Start("open")
+	Enable(nil, Off)
+	span.End("x", 1)
+	if Enabled() || strings.Contains(buf.String(), "◂") {
+		t.Errorf("got %q, want only the start", buf.String())
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []Format{Off, Tree, JSON} {
+		if got, err := ParseFormat(f.String()); err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %v, %v", f.String(), got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(\"xml\") should fail")
+	}
+}
@@ -0,0 +1,232 @@
+// Package unionfind tracks which elements are connected into the same set
+// (disjoint-set forest with path compression and union by rank).
+package unionfind
+
+// UnionFind partitions keys of any comparable type into disjoint sets.
+// Keys are added on first use, each in a set of its own.
+type UnionFind[K comparable] struct {
+	index  map[K]int // key -> dense index
+	keys   []K       // dense index -> key
+	parent []int     // parent[i] = parent of node i (or itself if root)
+	rank   []int     // rank[i] = approximate depth of subtree rooted at i
+	size   []int     // size[i] = number of nodes in set rooted at i
+	count  int       // number of disjoint sets
+
+	// trail records every overwritten value while a snapshot is live, so
+	// Rollback can undo changes in reverse order
+	recording bool
+	trail     []change
+	snapshots int // live snapshots, those not yet rolled back
+}
+
+// field names the state a change overwrote
+type field int
+
+const (
+	parentField field = iota
+	rankField
+	sizeField
+	countField
+)
+
+// change is one overwritten slot in parent, rank or size, or an
+// overwritten count
+type change struct {
+	field field
+	i     int // unused for countField
+	old   int
+}
+
+// Snapshot marks a state that Rollback can return to
+type Snapshot struct {
+	trail int
+	keys  int
+	depth int // live snapshots taken before this one
+}
+
+// New creates an empty UnionFind
+func New[K comparable]() *UnionFind[K] {
+	return &UnionFind[K]{index: make(map[K]int)}
+}
+
+// Add puts key in a set of its own if it is not present yet
+func (uf *UnionFind[K]) Add(key K) {
+	uf.indexOf(key)
+}
+
+func (uf *UnionFind[K]) indexOf(key K) int {
+	if i, ok := uf.index[key]; ok {
+		return i
+	}
+	i := len(uf.keys)
+	uf.index[key] = i
+	uf.keys = append(uf.keys, key)
+	uf.parent = append(uf.parent, i) // each element is its own parent
+	uf.rank = append(uf.rank, 0)
+	uf.size = append(uf.size, 1) // each set starts with size 1
+	uf.setCount(uf.count + 1)
+	return i
+}
+
+// Len returns the number of keys
+func (uf *UnionFind[K]) Len() int {
+	return len(uf.keys)
+}
+
+// Count returns the number of disjoint sets
+func (uf *UnionFind[K]) Count() int {
+	return uf.count
+}
+
+// Find returns the representative key of the set containing key
+func (uf *UnionFind[K]) Find(key K) K {
+	return uf.keys[uf.find(uf.indexOf(key))]
+}
+
+// find returns the root of x. Paths are not compressed while a snapshot is
+// live, so reads leave the trail alone; union by rank alone keeps trees
+// O(log n) deep meanwhile.
+func (uf *UnionFind[K]) find(x int) int {
+	if uf.recording {
+		for uf.parent[x] != x {
+			x = uf.parent[x]
+		}
+		return x
+	}
+	if uf.parent[x] != x {
+		uf.parent[x] = uf.find(uf.parent[x]) // Path compression
+	}
+	return uf.parent[x]
+}
+
+// Union merges the sets containing a and b.
+// Returns true if they were in different sets (and got merged).
+func (uf *UnionFind[K]) Union(a, b K) bool {
+	rootA := uf.find(uf.indexOf(a))
+	rootB := uf.find(uf.indexOf(b))
+
+	if rootA == rootB {
+		return false // already in same set
+	}
+
+	// Union by rank: attach smaller tree under larger tree
+	if uf.rank[rootA] < uf.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.set(parentField, rootB, rootA)
+	uf.set(sizeField, rootA, uf.size[rootA]+uf.size[rootB])
+	if uf.rank[rootA] == uf.rank[rootB] {
+		uf.set(rankField, rootA, uf.rank[rootA]+1)
+	}
+	uf.setCount(uf.count - 1)
+	return true
+}
+
+// Connected reports whether a and b are in the same set
+func (uf *UnionFind[K]) Connected(a, b K) bool {
+	return uf.find(uf.indexOf(a)) == uf.find(uf.indexOf(b))
+}
+
+// Size returns the number of keys in the set containing key
+func (uf *UnionFind[K]) Size(key K) int {
+	return uf.size[uf.find(uf.indexOf(key))]
+}
+
+// Components returns every set, ordered by when their first key was added.
+// Keys within a set keep the order they were added in.
+func (uf *UnionFind[K]) Components() [][]K {
+	byRoot := make(map[int]int) // root -> position in components
+	var components [][]K
+	for i, key := range uf.keys {
+		root := uf.find(i)
+		pos, ok := byRoot[root]
+		if !ok {
+			pos = len(components)
+			byRoot[root] = pos
+			components = append(components, make([]K, 0, uf.size[root]))
+		}
+		components[pos] = append(components[pos], key)
+	}
+	return components
+}
+
+// Sizes returns the size of every set, in the same order as Components
+func (uf *UnionFind[K]) Sizes() []int {
+	components := uf.Components()
+	sizes := make([]int, len(components))
+	for i, c := range components {
+		sizes[i] = len(c)
+	}
+	return sizes
+}
+
+// Snapshot records the current state so it can be restored with Rollback.
+// While a snapshot is live every change is remembered, which costs memory
+// proportional to the number of Union and Add calls since. Find, Connected
+// and the other reads of known keys record nothing, as path compression is
+// switched off until every snapshot has been rolled back.
+func (uf *UnionFind[K]) Snapshot() Snapshot {
+	uf.recording = true
+	uf.snapshots++
+	return Snapshot{trail: len(uf.trail), keys: len(uf.keys), depth: uf.snapshots - 1}
+}
+
+// Rollback undoes every Union and Add made after the snapshot was taken.
+// It uses up the snapshot and invalidates those taken after it; rolling
+// back the outermost one stops recording and turns path compression back on.
+func (uf *UnionFind[K]) Rollback(s Snapshot) {
+	for i := len(uf.trail) - 1; i >= s.trail; i-- {
+		c := uf.trail[i]
+		switch c.field {
+		case parentField:
+			uf.parent[c.i] = c.old
+		case rankField:
+			uf.rank[c.i] = c.old
+		case sizeField:
+			uf.size[c.i] = c.old
+		case countField:
+			uf.count = c.old
+		}
+	}
+	uf.trail = uf.trail[:s.trail]
+
+	for _, key := range uf.keys[s.keys:] {
+		delete(uf.index, key)
+	}
+	uf.keys = uf.keys[:s.keys]
+	uf.parent = uf.parent[:s.keys]
+	uf.rank = uf.rank[:s.keys]
+	uf.size = uf.size[:s.keys]
+
+	uf.snapshots = s.depth
+	if uf.snapshots == 0 {
+		uf.recording = false
+		uf.trail = nil
+	}
+}
+
+// setCount changes the number of sets, remembering the old value while recording
+func (uf *UnionFind[K]) setCount(value int) {
+	if uf.recording {
+		uf.trail = append(uf.trail, change{field: countField, old: uf.count})
+	}
+	uf.count = value
+}
+
+// set overwrites slot i of parent, rank or size, remembering the old value
+// while recording
+func (uf *UnionFind[K]) set(f field, i, value int) {
+	var slot *int
+	switch f {
+	case parentField:
+		slot = &uf.parent[i]
+	case rankField:
+		slot = &uf.rank[i]
+	case sizeField:
+		slot = &uf.size[i]
+	}
+	if uf.recording {
+		uf.trail = append(uf.trail, change{field: f, i: i, old: *slot})
+	}
+	*slot = value
+}
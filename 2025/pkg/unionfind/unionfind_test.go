@@ -0,0 +1,121 @@
+package unionfind
+
+import (
+	"slices"
+	"testing"
+)
+
+func equalComponents(a, b [][]string) bool {
+	return slices.EqualFunc(a, b, slices.Equal)
+}
+
+func TestNestedRollback(t *testing.T) {
+	uf := New[string]()
+	uf.Union("a", "b")
+	uf.Add("c")
+
+	outer := uf.Snapshot()
+	uf.Union("b", "c")
+	uf.Union("d", "e") // new keys after the snapshot
+	afterOuter := uf.Components()
+
+	inner := uf.Snapshot()
+	uf.Union("a", "e")
+	uf.Add("f")
+	if got, want := uf.Count(), 2; got != want {
+		t.Fatalf("Count() = %d before rollback, want %d", got, want)
+	}
+
+	uf.Rollback(inner)
+	if got, want := uf.Len(), 5; got != want {
+		t.Errorf("Len() = %d after inner rollback, want %d", got, want)
+	}
+	if got, want := uf.Count(), 2; got != want {
+		t.Errorf("Count() = %d after inner rollback, want %d", got, want)
+	}
+	if got := uf.Components(); !equalComponents(got, afterOuter) {
+		t.Errorf("Components() = %v after inner rollback, want %v", got, afterOuter)
+	}
+	if uf.Connected("a", "e") {
+		t.Error("a and e are still connected after inner rollback")
+	}
+
+	uf.Rollback(outer)
+	if got, want := uf.Len(), 3; got != want {
+		t.Errorf("Len() = %d after outer rollback, want %d", got, want)
+	}
+	if got, want := uf.Count(), 2; got != want {
+		t.Errorf("Count() = %d after outer rollback, want %d", got, want)
+	}
+	want := [][]string{{"a", "b"}, {"c"}}
+	if got := uf.Components(); !equalComponents(got, want) {
+		t.Errorf("Components() = %v after outer rollback, want %v", got, want)
+	}
+
+	// Keys removed by the rollback come back as new singletons
+	uf.Add("d")
+	if got, want := uf.Size("d"), 1; got != want {
+		t.Errorf("Size(d) = %d after re-adding it, want %d", got, want)
+	}
+}
+
+func TestReadsAfterSnapshotRecordNothing(t *testing.T) {
+	uf := New[int]()
+	for i := 1; i < 64; i++ {
+		uf.Union(i-1, i)
+	}
+
+	uf.Snapshot()
+	trail := len(uf.trail)
+	for i := 0; i < 64; i++ {
+		uf.Find(i)
+		uf.Connected(0, i)
+		uf.Size(i)
+	}
+	uf.Components()
+	if len(uf.trail) != trail {
+		t.Errorf("reads grew the trail from %d to %d changes", trail, len(uf.trail))
+	}
+}
+
+func TestCompressionResumesAfterLastRollback(t *testing.T) {
+	// Union by rank of two equal trees builds a path of length log n
+	uf := New[int]()
+	for step := 1; step < 16; step *= 2 {
+		for i := 0; i < 16; i += 2 * step {
+			uf.Union(i+step, i)
+		}
+	}
+	leaf := uf.index[0]
+	root := leaf
+	for uf.parent[root] != root {
+		root = uf.parent[root] // not find, which would compress the path
+	}
+	if uf.parent[leaf] == root {
+		t.Fatal("setup: key 0 is already a child of the root")
+	}
+
+	outer := uf.Snapshot()
+	inner := uf.Snapshot()
+	uf.Union(100, 101)
+	uf.Rollback(inner)
+	uf.Find(0)
+	if !uf.recording || uf.parent[leaf] == root {
+		t.Fatal("paths were compressed while the outer snapshot is still live")
+	}
+
+	uf.Rollback(outer)
+	if uf.recording || len(uf.trail) != 0 {
+		t.Fatalf("still recording %d changes after the last rollback", len(uf.trail))
+	}
+	uf.Find(0)
+	if uf.parent[leaf] != root {
+		t.Errorf("parent of key 0 = %d after Find, want the root %d", uf.parent[leaf], root)
+	}
+
+	// Changes after the rollback are no longer remembered
+	uf.Union(0, 20)
+	if len(uf.trail) != 0 {
+		t.Errorf("Union after the last rollback recorded %d changes", len(uf.trail))
+	}
+}
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"iter"
+
+	"aoc2025/pkg/parser"
+)
+
+// Grid is a rectangular 2D grid addressed by Point2D, where X is the column
+// and Y is the row
+type Grid[T any] struct {
+	Width  int
+	Height int
+	cells  []T // row-major
+}
+
+// NewGrid creates a width x height grid with every cell set to fill
+func NewGrid[T any](width, height int, fill T) *Grid[T] {
+	cells := make([]T, width*height)
+	for i := range cells {
+		cells[i] = fill
+	}
+	return &Grid[T]{Width: width, Height: height, cells: cells}
+}
+
+// GridFromRows creates a grid from rows of cells.
+// Rows shorter than the longest one are padded with the zero value of T.
+func GridFromRows[T any](rows [][]T) *Grid[T] {
+	width := 0
+	for _, row := range rows {
+		width = Max(width, len(row))
+	}
+
+	g := &Grid[T]{Width: width, Height: len(rows), cells: make([]T, width*len(rows))}
+	for y, row := range rows {
+		copy(g.cells[y*width:], row)
+	}
+	return g
+}
+
+// GridFromInput creates a character grid from the input lines, skipping empty lines
+func GridFromInput(input *parser.Input) *Grid[rune] {
+	return GridFromRows(input.ToCharGrid())
+}
+
+// InBounds checks if a point is inside the grid
+func (g *Grid[T]) InBounds(p Point2D) bool {
+	return InBounds(p, g.Width, g.Height)
+}
+
+// At returns the cell at p, panicking if p is out of bounds
+func (g *Grid[T]) At(p Point2D) T {
+	if !g.InBounds(p) {
+		panic("grid: point out of bounds")
+	}
+	return g.cells[p.Y*g.Width+p.X]
+}
+
+// Get returns the cell at p and whether p is inside the grid
+func (g *Grid[T]) Get(p Point2D) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Y*g.Width+p.X], true
+}
+
+// Set changes the cell at p, panicking if p is out of bounds
+func (g *Grid[T]) Set(p Point2D, value T) {
+	if !g.InBounds(p) {
+		panic("grid: point out of bounds")
+	}
+	g.cells[p.Y*g.Width+p.X] = value
+}
+
+// All iterates over every cell in row-major order
+func (g *Grid[T]) All() iter.Seq2[Point2D, T] {
+	return func(yield func(Point2D, T) bool) {
+		for i, value := range g.cells {
+			if !yield(Point2D{X: i % g.Width, Y: i / g.Width}, value) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors iterates over the in-bounds neighbors of p in the given
+// directions, such as Cardinals or AllDirs
+func (g *Grid[T]) Neighbors(p Point2D, dirs []Point2D) iter.Seq2[Point2D, T] {
+	return func(yield func(Point2D, T) bool) {
+		for _, dir := range dirs {
+			next := p.Add(dir)
+			if !g.InBounds(next) {
+				continue
+			}
+			if !yield(next, g.cells[next.Y*g.Width+next.X]) {
+				return
+			}
+		}
+	}
+}
+
+// Row returns a copy of row y
+func (g *Grid[T]) Row(y int) []T {
+	row := make([]T, g.Width)
+	copy(row, g.cells[y*g.Width:(y+1)*g.Width])
+	return row
+}
+
+// Column returns a copy of column x
+func (g *Grid[T]) Column(x int) []T {
+	column := make([]T, g.Height)
+	for y := range column {
+		column[y] = g.cells[y*g.Width+x]
+	}
+	return column
+}
+
+// Rows returns a copy of the grid as a slice of rows
+func (g *Grid[T]) Rows() [][]T {
+	rows := make([][]T, g.Height)
+	for y := range rows {
+		rows[y] = g.Row(y)
+	}
+	return rows
+}
+
+// Clone returns a copy of the grid
+func (g *Grid[T]) Clone() *Grid[T] {
+	cells := make([]T, len(g.cells))
+	copy(cells, g.cells)
+	return &Grid[T]{Width: g.Width, Height: g.Height, cells: cells}
+}
+
+// Transpose returns a new grid with rows and columns swapped
+func (g *Grid[T]) Transpose() *Grid[T] {
+	t := &Grid[T]{Width: g.Height, Height: g.Width, cells: make([]T, len(g.cells))}
+	for i, value := range g.cells {
+		x, y := i%g.Width, i/g.Width
+		t.cells[x*t.Width+y] = value
+	}
+	return t
+}
+
+// RotateClockwise returns a new grid rotated a quarter turn clockwise
+func (g *Grid[T]) RotateClockwise() *Grid[T] {
+	r := &Grid[T]{Width: g.Height, Height: g.Width, cells: make([]T, len(g.cells))}
+	for i, value := range g.cells {
+		x, y := i%g.Width, i/g.Width
+		// (x, y) moves to column height-1-y, row x
+		r.cells[x*r.Width+(g.Height-1-y)] = value
+	}
+	return r
+}
+
+// RotateCounterClockwise returns a new grid rotated a quarter turn counter-clockwise
+func (g *Grid[T]) RotateCounterClockwise() *Grid[T] {
+	r := &Grid[T]{Width: g.Height, Height: g.Width, cells: make([]T, len(g.cells))}
+	for i, value := range g.cells {
+		x, y := i%g.Width, i/g.Width
+		// (x, y) moves to column y, row width-1-x
+		r.cells[(g.Width-1-x)*r.Width+y] = value
+	}
+	return r
+}
+
+// FlipHorizontal returns a new grid mirrored left to right
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	f := &Grid[T]{Width: g.Width, Height: g.Height, cells: make([]T, len(g.cells))}
+	for i, value := range g.cells {
+		x, y := i%g.Width, i/g.Width
+		f.cells[y*g.Width+(g.Width-1-x)] = value
+	}
+	return f
+}
+
+// FlipVertical returns a new grid mirrored top to bottom
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	f := &Grid[T]{Width: g.Width, Height: g.Height, cells: make([]T, len(g.cells))}
+	for y := 0; y < g.Height; y++ {
+		copy(f.cells[(g.Height-1-y)*g.Width:], g.cells[y*g.Width:(y+1)*g.Width])
+	}
+	return f
+}
+
+// Find returns the first point holding value in row-major order
+func Find[T comparable](g *Grid[T], value T) (Point2D, bool) {
+	for i, cell := range g.cells {
+		if cell == value {
+			return Point2D{X: i % g.Width, Y: i / g.Width}, true
+		}
+	}
+	return Point2D{}, false
+}
+
+// FindAll returns every point holding value in row-major order
+func FindAll[T comparable](g *Grid[T], value T) []Point2D {
+	var points []Point2D
+	for i, cell := range g.cells {
+		if cell == value {
+			points = append(points, Point2D{X: i % g.Width, Y: i / g.Width})
+		}
+	}
+	return points
+}
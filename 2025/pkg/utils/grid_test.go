@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+
+	"aoc2025/pkg/parser"
+)
+
+// letters builds a rune grid from equal-length rows
+func letters(rows ...string) *Grid[rune] {
+	return GridFromInput(parser.FromLines(rows))
+}
+
+func equalGrids(a, b *Grid[rune]) bool {
+	return a.Width == b.Width && a.Height == b.Height && slices.Equal(a.cells, b.cells)
+}
+
+func TestTransforms(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *Grid[rune]
+		f    func(*Grid[rune]) *Grid[rune]
+		want *Grid[rune]
+	}{
+		// Square
+		{"transpose square", letters("ab", "cd"), (*Grid[rune]).Transpose, letters("ac", "bd")},
+		{"clockwise square", letters("ab", "cd"), (*Grid[rune]).RotateClockwise, letters("ca", "db")},
+		{"counter-clockwise square", letters("ab", "cd"), (*Grid[rune]).RotateCounterClockwise, letters("bd", "ac")},
+		{"flip horizontal square", letters("ab", "cd"), (*Grid[rune]).FlipHorizontal, letters("ba", "dc")},
+		{"flip vertical square", letters("ab", "cd"), (*Grid[rune]).FlipVertical, letters("cd", "ab")},
+
+		// Wider than tall: 3x2 becomes 2x3 when turned
+		{"transpose wide", letters("abc", "def"), (*Grid[rune]).Transpose, letters("ad", "be", "cf")},
+		{"clockwise wide", letters("abc", "def"), (*Grid[rune]).RotateClockwise, letters("da", "eb", "fc")},
+		{"counter-clockwise wide", letters("abc", "def"), (*Grid[rune]).RotateCounterClockwise, letters("cf", "be", "ad")},
+		{"flip horizontal wide", letters("abc", "def"), (*Grid[rune]).FlipHorizontal, letters("cba", "fed")},
+		{"flip vertical wide", letters("abc", "def"), (*Grid[rune]).FlipVertical, letters("def", "abc")},
+
+		// A single row and a single column
+		{"clockwise row", letters("abc"), (*Grid[rune]).RotateClockwise, letters("a", "b", "c")},
+		{"counter-clockwise column", letters("a", "b", "c"), (*Grid[rune]).RotateCounterClockwise, letters("abc")},
+	}
+	for _, tt := range tests {
+		if got := tt.f(tt.in); !equalGrids(got, tt.want) {
+			t.Errorf("%s: got %q (%dx%d), want %q", tt.name, got.Rows(), got.Width, got.Height, tt.want.Rows())
+		}
+	}
+}
+
+func TestTransformIdentities(t *testing.T) {
+	for _, g := range []*Grid[rune]{letters("ab", "cd"), letters("abc", "def"), letters("abcd", "efgh", "ijkl")} {
+		original := g.Clone()
+		cw := (*Grid[rune]).RotateClockwise
+		ccw := (*Grid[rune]).RotateCounterClockwise
+
+		checks := map[string]*Grid[rune]{
+			"four clockwise turns":         cw(cw(cw(cw(g)))),
+			"clockwise then back":          ccw(cw(g)),
+			"transpose twice":              g.Transpose().Transpose(),
+			"flip horizontal twice":        g.FlipHorizontal().FlipHorizontal(),
+			"flip vertical twice":          g.FlipVertical().FlipVertical(),
+			"half turn is both flips":      cw(cw(g)).FlipHorizontal().FlipVertical(),
+			"clockwise is transpose, flip": cw(g).FlipHorizontal().Transpose(),
+		}
+		for name, got := range checks {
+			if !equalGrids(got, original) {
+				t.Errorf("%q: %s gave %q", original.Rows(), name, got.Rows())
+			}
+		}
+		if !equalGrids(g, original) {
+			t.Errorf("transforms modified the grid: %q", g.Rows())
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := letters("abc", "def")
+	collect := func(p Point2D, dirs []Point2D) string {
+		var got []rune
+		for q, v := range g.Neighbors(p, dirs) {
+			if g.At(q) != v {
+				t.Errorf("neighbor %v reported as %c, holds %c", q, v, g.At(q))
+			}
+			got = append(got, v)
+		}
+		return string(got)
+	}
+
+	tests := []struct {
+		p    Point2D
+		dirs []Point2D
+		want string
+	}{
+		{Point2D{X: 1, Y: 0}, Cardinals, "eac"}, // up is off the grid
+		{Point2D{X: 0, Y: 0}, Cardinals, "db"},  // corner
+		{Point2D{X: 2, Y: 1}, Cardinals, "ce"},
+		{Point2D{X: 1, Y: 1}, AllDirs, "bdfac"},
+		{Point2D{X: 0, Y: 1}, Diagonals, "b"},
+		{Point2D{X: 5, Y: 5}, AllDirs, ""}, // far outside
+	}
+	for _, tt := range tests {
+		if got := collect(tt.p, tt.dirs); got != tt.want {
+			t.Errorf("Neighbors(%v) = %q, want %q", tt.p, got, tt.want)
+		}
+	}
+
+	// Stopping early is honored
+	count := 0
+	for range g.Neighbors(Point2D{X: 1, Y: 1}, AllDirs) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("iteration continued after break: %d", count)
+	}
+}
+
+func TestRowsColumnsAndFind(t *testing.T) {
+	g := GridFromRows([][]int{{1, 2, 3}, {4}})
+	if !slices.Equal(g.Row(1), []int{4, 0, 0}) || !slices.Equal(g.Column(2), []int{3, 0}) {
+		t.Errorf("Row(1) = %v, Column(2) = %v", g.Row(1), g.Column(2))
+	}
+
+	// Copies do not alias the grid
+	g.Row(0)[0] = 9
+	g.Rows()[0][1] = 9
+	if g.At(Point2D{X: 0, Y: 0}) != 1 || g.At(Point2D{X: 1, Y: 0}) != 2 {
+		t.Error("changing a copied row changed the grid")
+	}
+
+	if p, ok := Find(g, 0); !ok || p != (Point2D{X: 1, Y: 1}) {
+		t.Errorf("Find(0) = %v, %v", p, ok)
+	}
+	if got := FindAll(g, 0); !slices.Equal(got, []Point2D{{X: 1, Y: 1}, {X: 2, Y: 1}}) {
+		t.Errorf("FindAll(0) = %v", got)
+	}
+	if _, ok := g.Get(Point2D{X: 3, Y: 0}); ok {
+		t.Error("Get outside the grid reported ok")
+	}
+}